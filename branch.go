@@ -18,6 +18,7 @@ type branchCmd struct {
 	Track    branchTrackCmd    `cmd:"" aliases:"tr" help:"Track a branch"`
 	Untrack  branchUntrackCmd  `cmd:"" aliases:"untr" help:"Forget a tracked branch"`
 	Checkout branchCheckoutCmd `cmd:"" aliases:"co" help:"Switch to a branch"`
+	Blame    branchBlameCmd    `cmd:"" help:"Blame a file, annotated by stack branch"`
 
 	// Creation and destruction
 	Create branchCreateCmd `cmd:"" aliases:"c" help:"Create a new branch"`
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alecthomas/kong"
+	"go.abhg.dev/gs/internal/git"
+	"go.abhg.dev/gs/internal/spice"
+	"go.abhg.dev/gs/internal/text"
+)
+
+type branchBlameCmd struct {
+	Path string `arg:"" help:"Path of the file to blame"`
+
+	Branch string `help:"Branch to start the stack from" placeholder:"NAME" predictor:"trackedBranches"`
+}
+
+func (*branchBlameCmd) Help() string {
+	return text.Dedent(`
+		Each hunk is labeled with the branch in the current stack
+		that introduced it. Hunks introduced by commits outside the
+		stack (e.g. on trunk) are left unlabeled.
+
+		Use --branch to blame relative to a different branch's stack.
+	`)
+}
+
+func (cmd *branchBlameCmd) AfterApply(ctx context.Context, wt *git.Worktree) error {
+	if cmd.Branch == "" {
+		branch, err := wt.CurrentBranch(ctx)
+		if err != nil {
+			return fmt.Errorf("get current branch: %w", err)
+		}
+		cmd.Branch = branch
+	}
+	return nil
+}
+
+func (cmd *branchBlameCmd) Run(
+	ctx context.Context,
+	kctx *kong.Context,
+	repo *git.Repository,
+	svc *spice.Service,
+) error {
+	stack, err := svc.ListStack(ctx, cmd.Branch)
+	if err != nil {
+		return fmt.Errorf("list stack: %w", err)
+	}
+
+	// Map each commit in the stack to the branch that introduced it,
+	// so hunks from git blame can be labeled with their branch.
+	branchOf := make(map[git.Hash]string)
+	for _, branch := range stack {
+		resp, err := svc.LookupBranch(ctx, branch)
+		if err != nil {
+			return fmt.Errorf("look up %v: %w", branch, err)
+		}
+
+		hashes, err := repo.ListCommits(ctx,
+			git.CommitRangeFrom(resp.Head).ExcludeFrom(resp.BaseHash))
+		if err != nil {
+			return fmt.Errorf("list commits for %v: %w", branch, err)
+		}
+
+		for _, hash := range hashes {
+			branchOf[hash] = branch
+		}
+	}
+
+	for hunk, err := range repo.BlameHunks(ctx, git.BlameRequest{Path: cmd.Path}) {
+		if err != nil {
+			return fmt.Errorf("blame: %w", err)
+		}
+
+		branch := branchOf[hunk.Commit.Hash]
+		for i := range hunk.LineCount {
+			fmt.Fprintf(kctx.Stdout, "%-20s %6d  %s\n",
+				branch, hunk.FinalLine+i, hunk.Commit.Summary)
+		}
+	}
+
+	return nil
+}
@@ -3,7 +3,9 @@ package main
 import (
 	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/charmbracelet/log"
 	"go.abhg.dev/gs/internal/git"
@@ -73,6 +75,14 @@ func (cmd *commitPickCmd) Run(
 		// This still won't allow for no-op cherry-picks.
 		AllowEmpty: true,
 	})
+	var interruptErr *git.CherryPickInterruptedError
+	if errors.As(err, &interruptErr) && interruptErr.Kind == git.CherryPickInterruptConflict && len(interruptErr.Conflicts) > 0 {
+		paths := make([]string, len(interruptErr.Conflicts))
+		for i, c := range interruptErr.Conflicts {
+			paths[i] = c.Path
+		}
+		log.Errorf("%d file(s) conflict: %s", len(paths), strings.Join(paths, ", "))
+	}
 	if err != nil {
 		return fmt.Errorf("cherry-pick: %w", err)
 	}
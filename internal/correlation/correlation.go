@@ -0,0 +1,61 @@
+// Package correlation generates and propagates a correlation ID
+// for a single gs invocation, so that forge API requests and git
+// subprocesses started during that invocation can be tied back
+// together in logs.
+package correlation
+
+import (
+	"context"
+	"net/http"
+
+	"go.abhg.dev/gs/internal/random"
+)
+
+// New generates a new correlation ID.
+//
+// IDs are opaque, URL-safe strings.
+// Callers should not assume any particular format.
+func New() string {
+	return random.Alnum(20)
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying the given correlation ID.
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext reports the correlation ID stored in ctx, if any.
+func FromContext(ctx context.Context) (id string, ok bool) {
+	id, ok = ctx.Value(contextKey{}).(string)
+	return id, ok
+}
+
+// HeaderName is the HTTP header used to propagate
+// the correlation ID on outgoing forge API requests.
+const HeaderName = "X-Request-ID"
+
+// Transport wraps base so that every request it sends carries
+// the correlation ID found in the request's context, if any,
+// as the [HeaderName] header.
+//
+// If base is nil, [http.DefaultTransport] is used.
+func Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base}
+}
+
+type transport struct {
+	base http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if id, ok := FromContext(req.Context()); ok {
+		req = req.Clone(req.Context())
+		req.Header.Set(HeaderName, id)
+	}
+	return t.base.RoundTrip(req)
+}
@@ -6,21 +6,18 @@ import (
 	"os/exec"
 )
 
-// Command constructs a command to open the editor
-// with the given editor command.
-// The editor command may be a shell command or a binary name.
-func Command(edit string, args ...string) *exec.Cmd {
-	var cmd *exec.Cmd
-	if exe, err := exec.LookPath(edit); err == nil {
-		cmd = exec.Command(exe, args...)
-	} else {
-		// We'll run:
-		//   sh -c 'EDITOR "$@"' -- "$1" "$2" ...
-		// The shell will take care of quoting issues.
-		args = append([]string{"-c", edit + ` "$@"`, "--"}, args...)
-		cmd = exec.Command("sh", args...)
-	}
+// Command constructs a command to open the editor binary cmdName
+// with cmdArgs, followed by fileArgs (typically the file(s) to edit).
+//
+// cmdName and cmdArgs are expected to already be split,
+// e.g. by [Resolve]; use that instead of pre-splitting a raw
+// command line by hand.
+func Command(cmdName string, cmdArgs []string, fileArgs ...string) *exec.Cmd {
+	args := make([]string, 0, len(cmdArgs)+len(fileArgs))
+	args = append(args, cmdArgs...)
+	args = append(args, fileArgs...)
 
+	cmd := exec.Command(cmdName, args...)
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
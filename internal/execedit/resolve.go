@@ -0,0 +1,64 @@
+package execedit
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/buildkite/shellwords"
+)
+
+// GitVarer resolves the value of a Git variable,
+// as reported by 'git var'.
+//
+// [*git.Repository] satisfies this interface.
+type GitVarer interface {
+	Var(ctx context.Context, name string) (string, error)
+}
+
+// Resolve determines the editor command to use,
+// following git's own resolution order with one addition at the top:
+//
+//  1. $GIT_SPICE_EDITOR
+//  2. 'git var GIT_EDITOR', which itself checks core.editor,
+//     then $VISUAL, then $EDITOR
+//  3. $VISUAL
+//  4. $EDITOR
+//  5. a platform default ("vi" on Unix, "notepad" on Windows)
+//
+// Steps 3 through 5 only come into play if step 2 fails outright,
+// which should basically never happen.
+//
+// The resolved value is parsed as a shell command line,
+// so an editor like "code --wait" is split into a command
+// and its arguments instead of being treated as a single
+// (and likely non-existent) executable name.
+func Resolve(ctx context.Context, repo GitVarer) (cmd string, args []string, err error) {
+	editor := os.Getenv("GIT_SPICE_EDITOR")
+	if editor == "" {
+		if v, err := repo.Var(ctx, "GIT_EDITOR"); err == nil {
+			editor = v
+		} else {
+			editor = cmp.Or(os.Getenv("VISUAL"), os.Getenv("EDITOR"), defaultEditor())
+		}
+	}
+
+	parts, err := shellwords.SplitPosix(editor)
+	if err != nil {
+		return "", nil, fmt.Errorf("parse editor command %q: %w", editor, err)
+	}
+	if len(parts) == 0 {
+		return "", nil, fmt.Errorf("no editor configured")
+	}
+
+	return parts[0], parts[1:], nil
+}
+
+func defaultEditor() string {
+	if runtime.GOOS == "windows" {
+		return "notepad"
+	}
+	return "vi"
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 
+	"go.abhg.dev/gs/internal/correlation"
 	"go.abhg.dev/gs/internal/silog"
 )
 
@@ -23,8 +24,11 @@ func newClient(baseURL string, token *AuthenticationToken, log *silog.Logger) *c
 	return &client{
 		baseURL: baseURL,
 		token:   token,
-		http:    http.DefaultClient,
-		log:     log,
+		// Tag every outbound request with the invocation's correlation
+		// ID, so that server-side logs can be matched up with a
+		// user's bug report.
+		http: &http.Client{Transport: correlation.Transport(nil)},
+		log:  log,
 	}
 }
 
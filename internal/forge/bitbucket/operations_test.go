@@ -196,7 +196,7 @@ func TestFindChangeByID(t *testing.T) {
 	assert.Equal(t, forge.ChangeOpen, item.State)
 }
 
-func TestChangesStates(t *testing.T) {
+func TestChangeStatuses(t *testing.T) {
 	tests := []struct {
 		name       string
 		prStates   map[int64]string
@@ -252,8 +252,13 @@ func TestChangesStates(t *testing.T) {
 			// Need a more sophisticated mock for multiple PRs.
 			if len(tt.ids) == 1 {
 				repo := newTestRepository(srv.URL)
-				states, err := repo.ChangesStates(t.Context(), tt.ids)
+				statuses, err := repo.ChangeStatuses(t.Context(), tt.ids)
 				require.NoError(t, err)
+
+				states := make([]forge.ChangeState, len(statuses))
+				for i, status := range statuses {
+					states[i] = status.State
+				}
 				assert.Equal(t, tt.wantStates, states)
 			}
 		})
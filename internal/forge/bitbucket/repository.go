@@ -83,16 +83,6 @@ func (r *Repository) FindChangeByID(
 	return nil, ErrNotImplemented
 }
 
-// ChangesStates retrieves the states of multiple pull requests.
-//
-// This is a stub that will be implemented in a future PR.
-func (r *Repository) ChangesStates(
-	_ context.Context,
-	_ []forge.ChangeID,
-) ([]forge.ChangeState, error) {
-	return nil, ErrNotImplemented
-}
-
 // PostChangeComment posts a comment on a pull request.
 //
 // This is a stub that will be implemented in a future PR.
@@ -138,6 +128,68 @@ func (r *Repository) ListChangeComments(
 	}
 }
 
+// PostChangeReviewComment posts a new review comment on a pull request,
+// anchored to a specific line of the change's diff.
+//
+// This is a stub that will be implemented in a future PR.
+func (r *Repository) PostChangeReviewComment(
+	_ context.Context,
+	_ forge.ChangeID,
+	_ forge.ReviewCommentRequest,
+) (forge.ChangeCommentID, error) {
+	return nil, ErrNotImplemented
+}
+
+// UpdateChangeReviewComment updates the contents of
+// an existing review comment on a pull request.
+//
+// This is a stub that will be implemented in a future PR.
+func (r *Repository) UpdateChangeReviewComment(
+	_ context.Context,
+	_ forge.ChangeCommentID,
+	_ string,
+) error {
+	return ErrNotImplemented
+}
+
+// ListChangeReviewComments lists the review comments left on a pull
+// request, oldest first.
+//
+// This is a stub that will be implemented in a future PR.
+func (r *Repository) ListChangeReviewComments(
+	_ context.Context,
+	_ forge.ChangeID,
+	_ *forge.ListChangeReviewCommentsOptions,
+) iter.Seq2[*forge.ReviewComment, error] {
+	return func(yield func(*forge.ReviewComment, error) bool) {
+		yield(nil, ErrNotImplemented)
+	}
+}
+
+// ListChangeReviews lists the reviews left on a pull request, oldest first.
+//
+// This is a stub that will be implemented in a future PR.
+func (r *Repository) ListChangeReviews(
+	_ context.Context,
+	_ forge.ChangeID,
+) iter.Seq2[*forge.Review, error] {
+	return func(yield func(*forge.Review, error) bool) {
+		yield(nil, ErrNotImplemented)
+	}
+}
+
+// SubmitReview leaves a review on a pull request
+// on behalf of the authenticated user.
+//
+// This is a stub that will be implemented in a future PR.
+func (r *Repository) SubmitReview(
+	_ context.Context,
+	_ forge.ChangeID,
+	_ forge.SubmitReviewRequest,
+) error {
+	return ErrNotImplemented
+}
+
 // NewChangeMetadata returns the metadata for a pull request.
 func (r *Repository) NewChangeMetadata(
 	_ context.Context,
@@ -154,3 +206,13 @@ func (r *Repository) ListChangeTemplates(
 ) ([]*forge.ChangeTemplate, error) {
 	return nil, nil
 }
+
+// ReadFile reads the contents of a file at the given Git ref.
+//
+// This is a stub that will be implemented in a future PR.
+func (r *Repository) ReadFile(
+	_ context.Context,
+	_, _ string,
+) ([]byte, error) {
+	return nil, ErrNotImplemented
+}
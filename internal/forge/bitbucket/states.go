@@ -2,9 +2,12 @@ package bitbucket
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 
 	"go.abhg.dev/gs/internal/forge"
+	"go.abhg.dev/gs/internal/git"
 )
 
 // Bitbucket PR states.
@@ -15,28 +18,47 @@ const (
 	stateSuperseded = "SUPERSEDED"
 )
 
-// ChangesStates retrieves the states of multiple pull requests.
-func (r *Repository) ChangesStates(
+// ChangeStatuses retrieves the status of multiple pull requests.
+func (r *Repository) ChangeStatuses(
 	ctx context.Context,
 	ids []forge.ChangeID,
-) ([]forge.ChangeState, error) {
-	states := make([]forge.ChangeState, len(ids))
+) ([]*forge.ChangeStatus, error) {
+	statuses := make([]*forge.ChangeStatus, len(ids))
 	for i, id := range ids {
-		state, err := r.getChangeState(ctx, mustPR(id).Number)
+		status, err := r.getChangeStatus(ctx, mustPR(id).Number)
 		if err != nil {
-			return nil, fmt.Errorf("get state for PR #%d: %w", mustPR(id).Number, err)
+			var apiErr *apiError
+			if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+				statuses[i] = &forge.ChangeStatus{State: forge.ChangeNotFound}
+				continue
+			}
+			return nil, fmt.Errorf("get status for PR #%d: %w", mustPR(id).Number, err)
 		}
-		states[i] = state
+		statuses[i] = status
 	}
-	return states, nil
+	return statuses, nil
 }
 
-func (r *Repository) getChangeState(ctx context.Context, prID int64) (forge.ChangeState, error) {
+func (r *Repository) getChangeStatus(ctx context.Context, prID int64) (*forge.ChangeStatus, error) {
 	pr, err := r.getPullRequest(ctx, prID)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return stateFromAPI(pr.State), nil
+
+	// The Bitbucket API doesn't report a merge timestamp for a pull
+	// request, so MergedAt is left unset even for a merged change.
+	status := &forge.ChangeStatus{
+		State:   stateFromAPI(pr.State),
+		BaseRef: pr.Destination.Branch.Name,
+		IsDraft: pr.Draft,
+	}
+	if pr.Source.Commit != nil {
+		status.HeadSHA = git.Hash(pr.Source.Commit.Hash)
+	}
+	if pr.MergeCommit != nil {
+		status.MergeCommit = git.Hash(pr.MergeCommit.Hash)
+	}
+	return status, nil
 }
 
 func stateFromAPI(state string) forge.ChangeState {
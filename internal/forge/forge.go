@@ -10,11 +10,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"iter"
 	"sort"
 	"sync"
+	"time"
 
 	"go.abhg.dev/gs/internal/git"
 	"go.abhg.dev/gs/internal/secret"
+	"go.abhg.dev/gs/internal/ui"
 )
 
 var _forgeRegistry sync.Map
@@ -111,7 +114,7 @@ type Forge interface {
 	//
 	// The implementation should return a secret that the Forge
 	// can serialize and store for future use.
-	AuthenticationFlow(ctx context.Context) (AuthenticationToken, error)
+	AuthenticationFlow(ctx context.Context, view ui.View) (AuthenticationToken, error)
 
 	// SaveAuthenticationToken saves the given authentication token
 	// to the secret stash.
@@ -145,10 +148,39 @@ type Repository interface {
 	FindChangeByID(ctx context.Context, id ChangeID) (*FindChangeItem, error)
 	ChangeIsMerged(ctx context.Context, id ChangeID) (bool, error)
 
+	// ChangeStatuses retrieves the status of each of the given changes
+	// in bulk, in the same order as ids.
+	//
+	// A change ID that the forge can't resolve (e.g. because the
+	// change was deleted, or it belongs to a different repository
+	// than the one queried) is reported with a status of
+	// [ChangeNotFound] rather than failing the whole request.
+	ChangeStatuses(ctx context.Context, ids []ChangeID) ([]*ChangeStatus, error)
+
 	// Post and update comments on changes.
 	PostChangeComment(context.Context, ChangeID, string) (ChangeCommentID, error)
 	UpdateChangeComment(context.Context, ChangeCommentID, string) error
 
+	// PostChangeReviewComment posts a new review comment on a change,
+	// anchored to a specific line of the change's diff.
+	PostChangeReviewComment(context.Context, ChangeID, ReviewCommentRequest) (ChangeCommentID, error)
+
+	// UpdateChangeReviewComment updates the contents of
+	// an existing review comment on a change.
+	UpdateChangeReviewComment(context.Context, ChangeCommentID, string) error
+
+	// ListChangeReviewComments lists the review comments left on a
+	// change, oldest first.
+	ListChangeReviewComments(ctx context.Context, id ChangeID, opts *ListChangeReviewCommentsOptions) iter.Seq2[*ReviewComment, error]
+
+	// ListChangeReviews lists the reviews left on a change,
+	// oldest first.
+	ListChangeReviews(ctx context.Context, id ChangeID) iter.Seq2[*Review, error]
+
+	// SubmitReview leaves a review on a change on behalf of the
+	// authenticated user.
+	SubmitReview(ctx context.Context, id ChangeID, req SubmitReviewRequest) error
+
 	// NewChangeMetadata builds a ChangeMetadata for the given change ID.
 	//
 	// This may perform network requests to fetch additional information
@@ -160,6 +192,13 @@ type Repository interface {
 	//
 	// Returns an empty list if no templates are found.
 	ListChangeTemplates(context.Context) ([]*ChangeTemplate, error)
+
+	// ReadFile reads the contents of a file at the given Git ref,
+	// which may be a branch name, tag, or commit hash.
+	//
+	// If the path is tracked with Git LFS, this returns the
+	// LFS pointer recorded in the ref, not the content it resolves to.
+	ReadFile(ctx context.Context, ref, path string) ([]byte, error)
 }
 
 // ChangeID is a unique identifier for a change in a repository.
@@ -275,6 +314,39 @@ type FindChangeItem struct {
 
 	// Draft is true if the change is not yet ready to be reviewed.
 	Draft bool
+
+	// Verified reports whether the commit at HeadHash has a verified
+	// cryptographic signature, as determined by the forge.
+	Verified bool
+}
+
+// ChangeStatus is a single result from a bulk [Repository.ChangeStatuses]
+// query, carrying enough detail to distinguish closed-without-merge
+// from merged without a further round trip to the forge.
+type ChangeStatus struct {
+	// State is the current state of the change.
+	//
+	// This is [ChangeNotFound] if the forge couldn't resolve the
+	// change that was asked about.
+	State ChangeState
+
+	// MergedAt is the time at which the change was merged.
+	// It's the zero value if the change has not been merged.
+	MergedAt time.Time
+
+	// MergeCommit is the hash of the commit that the change was
+	// merged as. It's empty if the change has not been merged.
+	MergeCommit git.Hash
+
+	// HeadSHA is the hash of the commit at the top of the change.
+	HeadSHA git.Hash
+
+	// BaseRef is the name of the base branch
+	// that the change is proposed against.
+	BaseRef string
+
+	// IsDraft is true if the change is not yet ready to be reviewed.
+	IsDraft bool
 }
 
 // ChangeTemplate is a template for a new change proposal.
@@ -300,14 +372,29 @@ const (
 
 	// ChangeClosed specifies that a change has been closed.
 	ChangeClosed
+
+	// ChangeNotFound indicates that a bulk status query
+	// (see [Repository.ChangeStatuses]) could not find a change
+	// matching the requested ID -- for example, because the change
+	// was deleted, or it belongs to a different repository than the
+	// one queried.
+	//
+	// This is never persisted as the state of a tracked branch;
+	// it only ever appears in the result of a status query.
+	ChangeNotFound
 )
 
 func (s ChangeState) String() string {
-	b, err := s.MarshalText()
-	if err != nil {
-		return "unknown"
+	switch s {
+	case ChangeNotFound:
+		return "not found"
+	default:
+		b, err := s.MarshalText()
+		if err != nil {
+			return "unknown"
+		}
+		return string(b)
 	}
-	return string(b)
 }
 
 // MarshalText serialize the change state to text.
@@ -340,3 +427,202 @@ func (s *ChangeState) UnmarshalText(b []byte) error {
 	}
 	return nil
 }
+
+// Review is a single review left on a change by a reviewer.
+type Review struct {
+	// Reviewer is the username of the person that left the review.
+	Reviewer string
+
+	// State is the disposition of the review.
+	State ReviewState
+
+	// Body is the comment left with the review, if any.
+	Body string
+
+	// SubmittedAt is the time at which the review was submitted.
+	SubmittedAt time.Time
+}
+
+// SubmitReviewRequest is a request to leave a review on a change.
+type SubmitReviewRequest struct {
+	// State is the disposition of the review.
+	//
+	// Must be one of [ReviewApproved], [ReviewChangesRequested],
+	// or [ReviewCommented].
+	// [ReviewDismissed] is not submittable directly:
+	// it's only ever observed when listing reviews
+	// that were dismissed after the fact on the forge.
+	State ReviewState // required
+
+	// Body is the comment to leave with the review.
+	//
+	// Required for [ReviewChangesRequested] and [ReviewCommented].
+	Body string
+}
+
+// ReviewState is the disposition of a [Review].
+type ReviewState int
+
+const (
+	// ReviewApproved indicates that the reviewer approved the change.
+	ReviewApproved ReviewState = iota + 1
+
+	// ReviewChangesRequested indicates that the reviewer
+	// requested changes before the change can be merged.
+	ReviewChangesRequested
+
+	// ReviewCommented indicates that the reviewer left comments
+	// without explicitly approving or requesting changes.
+	ReviewCommented
+
+	// ReviewDismissed indicates that a previously submitted review
+	// was dismissed, and no longer counts towards the change's state.
+	ReviewDismissed
+)
+
+func (s ReviewState) String() string {
+	b, err := s.MarshalText()
+	if err != nil {
+		return "unknown"
+	}
+	return string(b)
+}
+
+// MarshalText serializes the review state to text.
+// This implements encoding.TextMarshaler.
+func (s ReviewState) MarshalText() ([]byte, error) {
+	switch s {
+	case ReviewApproved:
+		return []byte("approved"), nil
+	case ReviewChangesRequested:
+		return []byte("changes_requested"), nil
+	case ReviewCommented:
+		return []byte("commented"), nil
+	case ReviewDismissed:
+		return []byte("dismissed"), nil
+	default:
+		return nil, fmt.Errorf("unknown review state: %d", s)
+	}
+}
+
+// UnmarshalText parses the review state from text.
+// This implements encoding.TextUnmarshaler.
+func (s *ReviewState) UnmarshalText(b []byte) error {
+	switch string(b) {
+	case "approved":
+		*s = ReviewApproved
+	case "changes_requested":
+		*s = ReviewChangesRequested
+	case "commented":
+		*s = ReviewCommented
+	case "dismissed":
+		*s = ReviewDismissed
+	default:
+		return fmt.Errorf("unknown review state: %q", b)
+	}
+	return nil
+}
+
+// ReviewComment is a single inline comment left on a line of a change's
+// diff, as opposed to a top-level issue comment on the change as a whole.
+type ReviewComment struct {
+	// ID uniquely identifies the comment.
+	ID ChangeCommentID
+
+	// Path is the file the comment is anchored to.
+	Path string
+
+	// Line is the line number within Path that the comment is anchored to.
+	Line int
+
+	// Side is the side of the diff that Line refers to.
+	Side ReviewCommentSide
+
+	// Body is the Markdown body of the comment.
+	Body string
+
+	// InReplyTo is the ID of the comment this comment is a reply to,
+	// or nil if this comment starts a new thread.
+	InReplyTo ChangeCommentID
+}
+
+// ReviewCommentRequest is a request to leave a new inline review comment
+// on a change.
+type ReviewCommentRequest struct {
+	// Path is the file to comment on.
+	Path string // required
+
+	// Line is the line number within Path to comment on.
+	Line int // required
+
+	// Side is the side of the diff that Line refers to.
+	//
+	// Defaults to [ReviewCommentSideRight].
+	Side ReviewCommentSide
+
+	// Body is the Markdown body of the comment. // required
+	Body string // required
+
+	// InReplyTo, if set, is the ID of an existing review comment
+	// that this comment replies to.
+	//
+	// If set, Path, Line, and Side are ignored in favor of the
+	// thread that the referenced comment belongs to.
+	InReplyTo ChangeCommentID
+}
+
+// ReviewCommentSide is the side of a diff that a [ReviewComment] is
+// anchored to.
+type ReviewCommentSide int
+
+const (
+	// ReviewCommentSideRight indicates that the comment refers to a
+	// line in the new (proposed) version of the file.
+	ReviewCommentSideRight ReviewCommentSide = iota + 1
+
+	// ReviewCommentSideLeft indicates that the comment refers to a
+	// line in the old (base) version of the file.
+	ReviewCommentSideLeft
+)
+
+func (s ReviewCommentSide) String() string {
+	b, err := s.MarshalText()
+	if err != nil {
+		return "unknown"
+	}
+	return string(b)
+}
+
+// MarshalText serializes the review comment side to text.
+// This implements encoding.TextMarshaler.
+func (s ReviewCommentSide) MarshalText() ([]byte, error) {
+	switch s {
+	case ReviewCommentSideRight:
+		return []byte("right"), nil
+	case ReviewCommentSideLeft:
+		return []byte("left"), nil
+	default:
+		return nil, fmt.Errorf("unknown review comment side: %d", s)
+	}
+}
+
+// UnmarshalText parses the review comment side from text.
+// This implements encoding.TextUnmarshaler.
+func (s *ReviewCommentSide) UnmarshalText(b []byte) error {
+	switch string(b) {
+	case "right":
+		*s = ReviewCommentSideRight
+	case "left":
+		*s = ReviewCommentSideLeft
+	default:
+		return fmt.Errorf("unknown review comment side: %q", b)
+	}
+	return nil
+}
+
+// ListChangeReviewCommentsOptions specifies filtering options
+// for listing a change's review comments.
+type ListChangeReviewCommentsOptions struct {
+	// Path, if set, restricts the results to comments on this file.
+	Path string
+}
@@ -11,6 +11,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"regexp"
 	"strings"
@@ -101,7 +102,17 @@ func loadStashToken(t *testing.T, forgeURL string) string {
 }
 
 // NewHTTPRecorder creates a new HTTP recorder for the given test and name.
-func NewHTTPRecorder(t *testing.T, name string) *recorder.Recorder {
+//
+// Before a cassette is written, it redacts credentials that shouldn't
+// be committed to the repository: basic auth embedded in URLs, and
+// JSON body fields that look like OAuth tokens or client secrets (see
+// [HTTPRecorderOptions] to redact additional fields).
+func NewHTTPRecorder(t *testing.T, name string, opts ...HTTPRecorderOptions) *recorder.Recorder {
+	var opt HTTPRecorderOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
 	return httptest.NewTransportRecorder(t, name, httptest.TransportRecorderOptions{
 		Update: Update,
 		Matcher: func(r *http.Request, i cassette.Request) bool {
@@ -119,6 +130,8 @@ func NewHTTPRecorder(t *testing.T, name string) *recorder.Recorder {
 				r.URL.String() == i.URL &&
 				string(reqBody) == i.Body
 		},
+		MatchGraphQL: true,
+		AfterCapture: redactInteraction(opt),
 	})
 }
 
@@ -138,6 +151,19 @@ type (
 		repo forge.Repository,
 		changeID forge.ChangeID,
 	)
+
+	// SubmitReviewFunc leaves a review on a change using an identity
+	// other than the one used to open repo.
+	//
+	// This is necessary because forges generally don't allow the
+	// author of a change to review their own change,
+	// so exercising this needs a second, "alt" account.
+	SubmitReviewFunc func(
+		t *testing.T,
+		repo forge.Repository,
+		changeID forge.ChangeID,
+		req forge.SubmitReviewRequest,
+	)
 )
 
 // IntegrationConfig configures a forge integration test run.
@@ -159,6 +185,9 @@ type IntegrationConfig struct {
 	// CloseChange closes a change without merging.
 	CloseChange CloseChangeFunc // required
 
+	// SubmitReview leaves a review on a change from an alt account.
+	SubmitReview SubmitReviewFunc // required
+
 	// Reviewers is a list of usernames that can be added as reviewers to changes.
 	Reviewers []string // required
 
@@ -173,22 +202,81 @@ type IntegrationConfig struct {
 	// base branches to be absent when submitting changes.
 	// (GitLab does this. It's not clear why.)
 	BaseBranchMayBeAbsent bool // optional
+
+	// SupportsLFS indicates whether the test repository has
+	// Git LFS enabled, so that LFS-specific tests may run.
+	SupportsLFS bool // optional
+
+	// LFSEndpoint overrides the Git LFS batch API endpoint
+	// to use for the test repository.
+	//
+	// If empty, the default derived from RemoteURL is used.
+	LFSEndpoint string // optional
+
+	// SupportsSignedCommits indicates whether the forge under test
+	// can report the signature verification status of a commit,
+	// so that signed-commit-specific tests may run.
+	SupportsSignedCommits bool // optional
+
+	// SupportsSHA256 indicates that the remote test repository
+	// advertises support for the SHA-256 object format,
+	// so that SHA-256-specific tests may run.
+	SupportsSHA256 bool // optional
+
+	// InternalRefPattern is a template for the internal ref
+	// the forge maintains for a change, e.g. "refs/pull/{id}/head".
+	// The literal "{id}" is replaced with the change's numeric ID.
+	//
+	// If empty, the internal ref protection test is skipped.
+	InternalRefPattern string // optional
+
+	// TrunkBranches is the set of trunk branch names to run the
+	// suite against, e.g. []string{"main", "master", "release/next"}.
+	//
+	// Each name is run as its own top-level subtest, with its own
+	// fixtures, so that bugs that only manifest against a particular
+	// trunk naming convention (nested trunks in particular) are
+	// caught.
+	//
+	// Defaults to []string{"main"}.
+	TrunkBranches []string // optional
 }
 
 // RunIntegration runs integration tests with the given configuration.
 func RunIntegration(t *testing.T, config IntegrationConfig) {
+	trunks := config.TrunkBranches
+	if len(trunks) == 0 {
+		trunks = []string{"main"}
+	}
+
+	for _, trunk := range trunks {
+		t.Run(trunk, func(t *testing.T) {
+			t.Parallel()
+			runIntegrationTrunk(t, config, trunk)
+		})
+	}
+}
+
+func runIntegrationTrunk(t *testing.T, config IntegrationConfig, trunk string) {
 	suite := &integrationSuite{
 		Forge: config.Forge,
 		Fixtures: fixturetest.Config{
 			Update: Update,
 		},
-		RemoteURL:           config.RemoteURL,
-		openRepository:      config.OpenRepository,
-		MergeChange:         config.MergeChange,
-		CloseChange:         config.CloseChange,
-		Reviewers:           config.Reviewers,
-		Assignees:           config.Assignees,
-		SetCommentsPageSize: config.SetCommentsPageSize,
+		RemoteURL:             config.RemoteURL,
+		Trunk:                 trunk,
+		openRepository:        config.OpenRepository,
+		MergeChange:           config.MergeChange,
+		CloseChange:           config.CloseChange,
+		SubmitReview:          config.SubmitReview,
+		Reviewers:             config.Reviewers,
+		Assignees:             config.Assignees,
+		SetCommentsPageSize:   config.SetCommentsPageSize,
+		SupportsLFS:           config.SupportsLFS,
+		LFSEndpoint:           config.LFSEndpoint,
+		SupportsSignedCommits: config.SupportsSignedCommits,
+		SupportsSHA256:        config.SupportsSHA256,
+		InternalRefPattern:    config.InternalRefPattern,
 	}
 
 	t.Run("SubmitEditChange", func(t *testing.T) {
@@ -209,10 +297,10 @@ func RunIntegration(t *testing.T, config IntegrationConfig) {
 		suite.TestSubmitChangeDraft(t)
 	})
 
-	t.Run("ChangesStates", func(t *testing.T) {
+	t.Run("ChangeStatuses", func(t *testing.T) {
 		t.Parallel()
 
-		suite.TestChangeStates(t)
+		suite.TestChangeStatuses(t)
 	})
 
 	t.Run("FindChangesByBranchDoesNotExist", func(t *testing.T) {
@@ -222,7 +310,7 @@ func RunIntegration(t *testing.T, config IntegrationConfig) {
 	})
 
 	// NOTE: ListChangeTemplates cannot run in parallel
-	// because it modifies the main branch.
+	// because it modifies the trunk branch.
 	t.Run("ListChangeTemplates", func(t *testing.T) {
 		suite.TestListChangeTemplates(t)
 	})
@@ -258,6 +346,56 @@ func RunIntegration(t *testing.T, config IntegrationConfig) {
 
 		suite.TestChangeComments(t)
 	})
+
+	t.Run("ChangeReviewComments", func(t *testing.T) {
+		t.Parallel()
+
+		suite.TestChangeReviewComments(t)
+	})
+
+	t.Run("ChangeReviews", func(t *testing.T) {
+		t.Parallel()
+
+		suite.TestChangeReviews(t)
+	})
+
+	if config.SupportsLFS {
+		t.Run("SubmitChangeLFS", func(t *testing.T) {
+			t.Parallel()
+
+			suite.TestSubmitChangeLFS(t)
+		})
+
+		t.Run("SubmitWithLFS", func(t *testing.T) {
+			t.Parallel()
+
+			suite.TestSubmitWithLFS(t)
+		})
+	}
+
+	if config.InternalRefPattern != "" {
+		t.Run("SubmitDoesNotTouchInternalRefs", func(t *testing.T) {
+			t.Parallel()
+
+			suite.TestSubmitDoesNotTouchInternalRefs(t)
+		})
+	}
+
+	if config.SupportsSignedCommits {
+		t.Run("SubmitSignedCommits", func(t *testing.T) {
+			t.Parallel()
+
+			suite.TestSubmitSignedCommits(t)
+		})
+	}
+
+	if config.SupportsSHA256 {
+		t.Run("SubmitChangeSHA256", func(t *testing.T) {
+			t.Parallel()
+
+			suite.TestSubmitChangeSHA256(t)
+		})
+	}
 }
 
 type integrationSuite struct {
@@ -279,6 +417,9 @@ type integrationSuite struct {
 	// CloseChange closes a change without merging.
 	CloseChange CloseChangeFunc
 
+	// SubmitReview leaves a review on a change from an alt account.
+	SubmitReview SubmitReviewFunc
+
 	// Reviewers is a list of usernames that can be added as reviewers to changes.
 	Reviewers []string
 
@@ -288,6 +429,32 @@ type integrationSuite struct {
 	// SetCommentsPageSize sets the page size for listing comments.
 	SetCommentsPageSize func(testing.TB, int)
 
+	// SupportsLFS indicates whether the test repository has
+	// Git LFS enabled, so that LFS-specific tests may run.
+	SupportsLFS bool
+
+	// LFSEndpoint overrides the Git LFS batch API endpoint
+	// to use for the test repository.
+	LFSEndpoint string
+
+	// SupportsSignedCommits indicates whether the forge under test
+	// can report the signature verification status of a commit,
+	// so that signed-commit-specific tests may run.
+	SupportsSignedCommits bool
+
+	// SupportsSHA256 indicates that the remote test repository
+	// advertises support for the SHA-256 object format,
+	// so that SHA-256-specific tests may run.
+	SupportsSHA256 bool
+
+	// InternalRefPattern is a template for the internal ref
+	// the forge maintains for a change.
+	InternalRefPattern string
+
+	// Trunk is the name of the trunk branch to run the suite
+	// against, e.g. "main" or "master".
+	Trunk string
+
 	openRepository func(*testing.T, *http.Client) forge.Repository
 }
 
@@ -339,7 +506,7 @@ func (s *integrationSuite) TestSubmitEditChange(t *testing.T) {
 	change, err := repo.SubmitChange(t.Context(), forge.SubmitChangeRequest{
 		Subject: "Testing " + branchName,
 		Body:    "Test PR",
-		Base:    "main",
+		Base:    s.Trunk,
 		Head:    branchName,
 	})
 	require.NoError(t, err, "error creating PR")
@@ -352,7 +519,7 @@ func (s *integrationSuite) TestSubmitEditChange(t *testing.T) {
 		assert.Equal(t, commitHash, foundChange.HeadHash.String(),
 			"head hash should match first commit")
 		assert.Equal(t, "Testing "+branchName, foundChange.Subject, "subject should match")
-		assert.Equal(t, "main", foundChange.BaseName, "base name should match")
+		assert.Equal(t, s.Trunk, foundChange.BaseName, "base name should match")
 		assert.Equal(t, forge.ChangeOpen, foundChange.State, "state should be open")
 		assert.Equal(t, change.URL, foundChange.URL, "URL should match")
 	})
@@ -368,7 +535,7 @@ func (s *integrationSuite) TestSubmitEditChange(t *testing.T) {
 		assert.Equal(t, commitHash, foundChange.HeadHash.String(),
 			"head hash should match first commit")
 		assert.Equal(t, "Testing "+branchName, foundChange.Subject, "subject should match")
-		assert.Equal(t, "main", foundChange.BaseName, "base name should match")
+		assert.Equal(t, s.Trunk, foundChange.BaseName, "base name should match")
 		assert.Equal(t, forge.ChangeOpen, foundChange.State, "state should be open")
 		assert.Equal(t, change.URL, foundChange.URL, "URL should match")
 	})
@@ -392,8 +559,8 @@ func (s *integrationSuite) TestSubmitChangeBase(t *testing.T) {
 	if Update() {
 		testRepo := newTestRepository(t, s.RemoteURL)
 
-		// Push the base branch at current main position
-		testRepo.Push("main:" + baseName)
+		// Push the base branch at current trunk position
+		testRepo.Push(s.Trunk + ":" + baseName)
 		t.Cleanup(func() {
 			testRepo.DeleteRemoteBranch(baseName)
 		})
@@ -429,14 +596,14 @@ func (s *integrationSuite) TestSubmitChangeBase(t *testing.T) {
 
 	// Edit change to set base to main.
 	err = repo.EditChange(t.Context(), changeID, forge.EditChangeOptions{
-		Base: "main",
+		Base: s.Trunk,
 	})
 	require.NoError(t, err, "error changing PR base to main")
 
 	// Verify base changed to main.
 	foundChange, err = repo.FindChangeByID(t.Context(), changeID)
 	require.NoError(t, err, "error finding change after base change")
-	assert.Equal(t, "main", foundChange.BaseName, "base should be main")
+	assert.Equal(t, s.Trunk, foundChange.BaseName, "base should be main")
 }
 
 // Changes can be submitted as drafts, and edited to toggle draft status.
@@ -467,7 +634,7 @@ func (s *integrationSuite) TestSubmitChangeDraft(t *testing.T) {
 	change, err := repo.SubmitChange(t.Context(), forge.SubmitChangeRequest{
 		Subject: "Testing " + branchName,
 		Body:    "Test draft PR",
-		Base:    "main",
+		Base:    s.Trunk,
 		Head:    branchName,
 		Draft:   true,
 	})
@@ -479,20 +646,20 @@ func (s *integrationSuite) TestSubmitChangeDraft(t *testing.T) {
 	require.NoError(t, err, "error finding change by ID")
 	assert.True(t, foundChange.Draft, "change should be draft")
 
-	// Update to non-draft.
-	var draft bool
-	err = repo.EditChange(t.Context(), changeID, forge.EditChangeOptions{
-		Draft: &draft,
-	})
-	require.NoError(t, err, "error marking change as ready")
+	t.Run("MarkReady", func(t *testing.T) {
+		var draft bool
+		err := repo.EditChange(t.Context(), changeID, forge.EditChangeOptions{
+			Draft: &draft,
+		})
+		require.NoError(t, err, "error marking change as ready")
 
-	// Verify it's no longer a draft
-	foundChange, err = repo.FindChangeByID(t.Context(), changeID)
-	require.NoError(t, err, "error finding change after marking ready")
-	assert.False(t, foundChange.Draft, "change should not be draft")
+		foundChange, err := repo.FindChangeByID(t.Context(), changeID)
+		require.NoError(t, err, "error finding change after marking ready")
+		assert.False(t, foundChange.Draft, "change should not be draft")
+	})
 
 	// Update back to draft.
-	draft = true
+	draft := true
 	err = repo.EditChange(t.Context(), changeID, forge.EditChangeOptions{
 		Draft: &draft,
 	})
@@ -504,7 +671,7 @@ func (s *integrationSuite) TestSubmitChangeDraft(t *testing.T) {
 	assert.True(t, foundChange.Draft, "change should be draft again")
 }
 
-func (s *integrationSuite) TestChangeStates(t *testing.T) {
+func (s *integrationSuite) TestChangeStatuses(t *testing.T) {
 	// We'll create 3 PRs and put them each in a different state.
 	openBranchFixture := fixturetest.New(s.Fixtures, "openBranch", func() string {
 		return randomString(8)
@@ -528,7 +695,7 @@ func (s *integrationSuite) TestChangeStates(t *testing.T) {
 
 		// Create and push all three branches.
 		for _, branch := range []string{openBranch, mergedBranch, closedBranch} {
-			testRepo.CheckoutBranch("main")
+			testRepo.CheckoutBranch(s.Trunk)
 			testRepo.CreateBranch(branch)
 			testRepo.CheckoutBranch(branch)
 			testRepo.WriteFile(branch+".txt", randomString(32))
@@ -550,7 +717,7 @@ func (s *integrationSuite) TestChangeStates(t *testing.T) {
 	openChange, err := repo.SubmitChange(t.Context(), forge.SubmitChangeRequest{
 		Subject: "Open " + openBranch,
 		Body:    "Open change",
-		Base:    "main",
+		Base:    s.Trunk,
 		Head:    openBranch,
 	})
 	require.NoError(t, err, "error creating open change")
@@ -558,7 +725,7 @@ func (s *integrationSuite) TestChangeStates(t *testing.T) {
 	mergedChange, err := repo.SubmitChange(t.Context(), forge.SubmitChangeRequest{
 		Subject: "Merged " + mergedBranch,
 		Body:    "Merged change",
-		Base:    "main",
+		Base:    s.Trunk,
 		Head:    mergedBranch,
 	})
 	require.NoError(t, err, "error creating merged change")
@@ -566,7 +733,7 @@ func (s *integrationSuite) TestChangeStates(t *testing.T) {
 	closedChange, err := repo.SubmitChange(t.Context(), forge.SubmitChangeRequest{
 		Subject: "Closed " + closedBranch,
 		Body:    "Closed change",
-		Base:    "main",
+		Base:    s.Trunk,
 		Head:    closedBranch,
 	})
 	require.NoError(t, err)
@@ -574,13 +741,18 @@ func (s *integrationSuite) TestChangeStates(t *testing.T) {
 	s.MergeChange(t, repo, mergedChange.ID)
 	s.CloseChange(t, repo, closedChange.ID)
 
-	// Verify states.
-	states, err := repo.ChangesStates(t.Context(), []forge.ChangeID{
+	// Verify statuses.
+	statuses, err := repo.ChangeStatuses(t.Context(), []forge.ChangeID{
 		openChange.ID,
 		mergedChange.ID,
 		closedChange.ID,
 	})
-	require.NoError(t, err, "error fetching change states")
+	require.NoError(t, err, "error fetching change statuses")
+
+	states := make([]forge.ChangeState, len(statuses))
+	for i, status := range statuses {
+		states[i] = status.State
+	}
 	assert.Equal(t, []forge.ChangeState{
 		forge.ChangeOpen,
 		forge.ChangeMerged,
@@ -638,7 +810,7 @@ func (s *integrationSuite) TestListChangeTemplates(t *testing.T) {
 
 			if deleted {
 				testRepo.AddAllAndCommit("Remove all templates")
-				testRepo.Push("main")
+				testRepo.Push(s.Trunk)
 			}
 		}
 
@@ -678,7 +850,7 @@ func (s *integrationSuite) TestListChangeTemplates(t *testing.T) {
 				filepath.Join(templateDir, nonEmptyTemplateName))
 
 			testRepo.AddAllAndCommit("Add templates")
-			testRepo.Push("main")
+			testRepo.Push(s.Trunk)
 		}
 
 		ctx := t.Context()
@@ -755,7 +927,7 @@ func (s *integrationSuite) TestSubmitEditLabels(t *testing.T) {
 	change, err := repo.SubmitChange(t.Context(), forge.SubmitChangeRequest{
 		Subject: "Testing " + branchName,
 		Body:    "Test PR with labels",
-		Base:    "main",
+		Base:    s.Trunk,
 		Head:    branchName,
 		Labels:  []string{label1},
 	})
@@ -865,7 +1037,7 @@ func (s *integrationSuite) TestSubmitEditReviewers(t *testing.T) {
 		_, err := repo.SubmitChange(t.Context(), forge.SubmitChangeRequest{
 			Subject:   "Testing " + branchName,
 			Body:      "Test PR with reviewer",
-			Base:      "main",
+			Base:      s.Trunk,
 			Head:      branchName,
 			Reviewers: s.Reviewers,
 		})
@@ -907,7 +1079,7 @@ func (s *integrationSuite) TestSubmitEditReviewers(t *testing.T) {
 		change, err := repo.SubmitChange(t.Context(), forge.SubmitChangeRequest{
 			Subject: "Testing " + branchName,
 			Body:    "Test PR without reviewers",
-			Base:    "main",
+			Base:    s.Trunk,
 			Head:    branchName,
 		})
 		require.NoError(t, err, "error creating PR")
@@ -961,7 +1133,7 @@ func (s *integrationSuite) TestSubmitEditReviewers(t *testing.T) {
 			change, err := repo.SubmitChange(t.Context(), forge.SubmitChangeRequest{
 				Subject: "Testing " + branchName,
 				Body:    "Test PR without reviewers",
-				Base:    "main",
+				Base:    s.Trunk,
 				Head:    branchName,
 			})
 			require.NoError(t, err, "error creating PR")
@@ -1020,7 +1192,7 @@ func (s *integrationSuite) TestSubmitEditAssignees(t *testing.T) {
 		change, err := repo.SubmitChange(t.Context(), forge.SubmitChangeRequest{
 			Subject:   "Testing " + branchName,
 			Body:      "Test PR with assignee",
-			Base:      "main",
+			Base:      s.Trunk,
 			Head:      branchName,
 			Assignees: s.Assignees,
 		})
@@ -1070,7 +1242,7 @@ func (s *integrationSuite) TestSubmitEditAssignees(t *testing.T) {
 		change, err := repo.SubmitChange(t.Context(), forge.SubmitChangeRequest{
 			Subject: "Testing " + branchName,
 			Body:    "Test PR without assignees",
-			Base:    "main",
+			Base:    s.Trunk,
 			Head:    branchName,
 		})
 		require.NoError(t, err, "error creating PR")
@@ -1125,7 +1297,7 @@ func (s *integrationSuite) TestSubmitEditAssignees(t *testing.T) {
 			change, err := repo.SubmitChange(t.Context(), forge.SubmitChangeRequest{
 				Subject: "Testing " + branchName,
 				Body:    "Test PR without assignees",
-				Base:    "main",
+				Base:    s.Trunk,
 				Head:    branchName,
 			})
 			require.NoError(t, err, "error creating PR")
@@ -1174,7 +1346,7 @@ func (s *integrationSuite) TestChangeComments(t *testing.T) {
 	change, err := repo.SubmitChange(t.Context(), forge.SubmitChangeRequest{
 		Subject: "Testing " + branchName,
 		Body:    "Test PR for comments",
-		Base:    "main",
+		Base:    s.Trunk,
 		Head:    branchName,
 	})
 	require.NoError(t, err, "error creating PR")
@@ -1265,6 +1437,473 @@ func (s *integrationSuite) TestChangeComments(t *testing.T) {
 	})
 }
 
+// TestChangeReviewComments verifies that inline review comments can be
+// posted, updated, deleted, and listed with pagination.
+func (s *integrationSuite) TestChangeReviewComments(t *testing.T) {
+	branchFixture := fixturetest.New(s.Fixtures, "branch", func() string {
+		return randomString(8)
+	})
+	branchName := branchFixture.Get(t)
+	fileName := branchName + ".txt"
+	t.Logf("Creating branch: %s", branchName)
+
+	if Update() {
+		testRepo := newTestRepository(t, s.RemoteURL)
+
+		testRepo.CheckoutBranch(s.Trunk)
+		testRepo.WriteFile(fileName, "line one", "line two")
+		testRepo.AddAllAndCommit("add " + fileName)
+		testRepo.Push(s.Trunk)
+
+		testRepo.CreateBranch(branchName)
+		testRepo.CheckoutBranch(branchName)
+		testRepo.WriteFile(fileName, "line one changed", "line two changed")
+		testRepo.AddAllAndCommit("commit from test")
+		testRepo.Push(branchName)
+
+		t.Cleanup(func() {
+			testRepo.DeleteRemoteBranch(branchName)
+		})
+	}
+
+	repo := s.OpenRepository(t)
+
+	change, err := repo.SubmitChange(t.Context(), forge.SubmitChangeRequest{
+		Subject: "Testing " + branchName,
+		Body:    "Test PR for review comments",
+		Base:    s.Trunk,
+		Head:    branchName,
+	})
+	require.NoError(t, err, "error creating PR")
+	changeID := change.ID
+
+	bodiesFixture := fixturetest.New(s.Fixtures, "review-comments", func() []string {
+		return []string{randomString(32), randomString(32)}
+	})
+	bodies := bodiesFixture.Get(t)
+
+	rightComment, err := repo.PostChangeReviewComment(t.Context(), changeID, forge.ReviewCommentRequest{
+		Path: fileName,
+		Line: 1,
+		Side: forge.ReviewCommentSideRight,
+		Body: bodies[0],
+	})
+	require.NoError(t, err, "could not post comment on right side")
+	t.Logf("Posted right-side comment: %s", rightComment)
+
+	leftComment, err := repo.PostChangeReviewComment(t.Context(), changeID, forge.ReviewCommentRequest{
+		Path: fileName,
+		Line: 2,
+		Side: forge.ReviewCommentSideLeft,
+		Body: bodies[1],
+	})
+	require.NoError(t, err, "could not post comment on left side")
+	t.Logf("Posted left-side comment: %s", leftComment)
+
+	// Update the right-side comment.
+	t.Run("UpdateComment", func(t *testing.T) {
+		updatedBodyFixture := fixturetest.New(s.Fixtures, "updated-review-comment", func() string {
+			return randomString(32)
+		})
+		updatedBody := updatedBodyFixture.Get(t)
+
+		require.NoError(t,
+			repo.UpdateChangeReviewComment(t.Context(), rightComment, updatedBody),
+			"could not update comment")
+
+		bodies[0] = updatedBody
+	})
+
+	// Delete the left-side comment.
+	t.Run("DeleteComment", func(t *testing.T) {
+		require.NoError(t,
+			repo.DeleteChangeReviewComment(t.Context(), leftComment),
+			"could not delete comment")
+
+		bodies = bodies[:1]
+	})
+
+	// List remaining comments with pagination.
+	t.Run("ListReviewComments", func(t *testing.T) {
+		// Set a small page size to test pagination.
+		s.SetCommentsPageSize(t, 1)
+
+		var gotBodies []string
+		for comment, err := range repo.ListChangeReviewComments(t.Context(), changeID, nil /* opts */) {
+			require.NoError(t, err)
+			gotBodies = append(gotBodies, comment.Body)
+		}
+
+		assert.ElementsMatch(t, bodies, gotBodies)
+	})
+}
+
+// TestChangeReviews verifies that reviews submitted from an alt account
+// are reported back with the correct states and bodies.
+// Reviews are matched by state; the order they are listed in is not checked.
+func (s *integrationSuite) TestChangeReviews(t *testing.T) {
+	require.NotNil(t, s.SubmitReview, "test requires a SubmitReview hook")
+
+	branchFixture := fixturetest.New(s.Fixtures, "branch", func() string {
+		return randomString(8)
+	})
+	branchName := branchFixture.Get(t)
+	t.Logf("Creating branch: %s", branchName)
+
+	if Update() {
+		testRepo := newTestRepository(t, s.RemoteURL)
+
+		testRepo.CreateBranch(branchName)
+		testRepo.CheckoutBranch(branchName)
+		testRepo.WriteFile(branchName+".txt", randomString(32))
+		testRepo.AddAllAndCommit("commit from test")
+		testRepo.Push(branchName)
+
+		t.Cleanup(func() {
+			testRepo.DeleteRemoteBranch(branchName)
+		})
+	}
+
+	repo := s.OpenRepository(t)
+
+	change, err := repo.SubmitChange(t.Context(), forge.SubmitChangeRequest{
+		Subject: "Testing " + branchName,
+		Body:    "Test PR for reviews",
+		Base:    s.Trunk,
+		Head:    branchName,
+	})
+	require.NoError(t, err, "error creating PR")
+	changeID := change.ID
+
+	commentedBodyFixture := fixturetest.New(s.Fixtures, "commented-review-body", func() string {
+		return randomString(32)
+	})
+	changesRequestedBodyFixture := fixturetest.New(s.Fixtures, "changes-requested-review-body", func() string {
+		return randomString(32)
+	})
+	commentedBody := commentedBodyFixture.Get(t)
+	changesRequestedBody := changesRequestedBodyFixture.Get(t)
+
+	// Leave reviews, in order, from the alt account.
+	s.SubmitReview(t, repo, changeID, forge.SubmitReviewRequest{
+		State: forge.ReviewCommented,
+		Body:  commentedBody,
+	})
+	s.SubmitReview(t, repo, changeID, forge.SubmitReviewRequest{
+		State: forge.ReviewChangesRequested,
+		Body:  changesRequestedBody,
+	})
+	s.SubmitReview(t, repo, changeID, forge.SubmitReviewRequest{
+		State: forge.ReviewApproved,
+	})
+
+	var reviews []*forge.Review
+	for review, err := range repo.ListChangeReviews(t.Context(), changeID) {
+		require.NoError(t, err)
+		reviews = append(reviews, review)
+	}
+	require.Len(t, reviews, 3, "expected exactly three reviews")
+
+	// Forges don't all agree on how approvals and comment-only reviews
+	// interleave chronologically (e.g. GitLab reports approvals
+	// separately from notes), so match by state rather than position.
+	byState := make(map[forge.ReviewState]*forge.Review, len(reviews))
+	for _, review := range reviews {
+		byState[review.State] = review
+	}
+
+	require.Contains(t, byState, forge.ReviewCommented)
+	assert.Equal(t, commentedBody, byState[forge.ReviewCommented].Body)
+
+	require.Contains(t, byState, forge.ReviewChangesRequested)
+	assert.Equal(t, changesRequestedBody, byState[forge.ReviewChangesRequested].Body)
+
+	require.Contains(t, byState, forge.ReviewApproved)
+}
+
+// TestSubmitChangeLFS submits a change whose head commit adds a file
+// tracked with Git LFS, and verifies that the forge reports the
+// correct head hash, and that reading the file back returns the LFS
+// pointer recorded in Git, not the content it resolves to.
+func (s *integrationSuite) TestSubmitChangeLFS(t *testing.T) {
+	branchFixture := fixturetest.New(s.Fixtures, "branch", func() string {
+		return randomString(8)
+	})
+	commitHashFixture, setCommitHash := fixturetest.Stored[string](s.Fixtures, "commitHash")
+
+	branchName := branchFixture.Get(t)
+	t.Logf("Creating branch: %s", branchName)
+
+	const lfsPath = "large.bin"
+	if Update() {
+		testRepo := newTestRepository(t, s.RemoteURL, withLFS())
+		if s.LFSEndpoint != "" {
+			testRepo.SetLFSEndpoint(s.LFSEndpoint)
+		}
+
+		testRepo.CreateBranch(branchName)
+		testRepo.CheckoutBranch(branchName)
+		testRepo.WriteFile(".gitattributes", lfsPath+" filter=lfs diff=lfs merge=lfs -text")
+		testRepo.WriteLFSFile(lfsPath, 1<<20) // 1 MiB, large enough to exercise the clean filter.
+		hash := testRepo.AddAllAndCommit("add LFS-tracked file")
+		testRepo.Push(branchName)
+		setCommitHash(hash.String())
+
+		t.Cleanup(func() {
+			testRepo.DeleteRemoteBranch(branchName)
+		})
+	}
+	commitHash := commitHashFixture.Get(t)
+
+	repo := s.OpenRepository(t)
+
+	change, err := repo.SubmitChange(t.Context(), forge.SubmitChangeRequest{
+		Subject: "Testing LFS " + branchName,
+		Body:    "Test PR with an LFS-tracked file",
+		Base:    s.Trunk,
+		Head:    branchName,
+	})
+	require.NoError(t, err, "error creating PR")
+
+	foundChange, err := repo.FindChangeByID(t.Context(), change.ID)
+	require.NoError(t, err, "error finding change by ID")
+	assert.Equal(t, commitHash, foundChange.HeadHash.String(),
+		"head hash should match the pushed LFS commit")
+
+	content, err := repo.ReadFile(t.Context(), branchName, lfsPath)
+	require.NoError(t, err, "error reading LFS-tracked file")
+	assert.Contains(t, string(content), "version https://git-lfs.github.com/spec/v1",
+		"file content should be the LFS pointer, not the raw blob")
+}
+
+// TestSubmitWithLFS is like TestSubmitChangeLFS, but it tracks the
+// file with a glob pattern via [testRepository.TrackLFS] instead of
+// hand-writing .gitattributes for a single path, exercising the more
+// common case of a stack built on top of an already LFS-tracked
+// directory.
+func (s *integrationSuite) TestSubmitWithLFS(t *testing.T) {
+	branchFixture := fixturetest.New(s.Fixtures, "branch", func() string {
+		return randomString(8)
+	})
+	commitHashFixture, setCommitHash := fixturetest.Stored[string](s.Fixtures, "commitHash")
+
+	branchName := branchFixture.Get(t)
+	t.Logf("Creating branch: %s", branchName)
+
+	const lfsPath = "assets/large.bin"
+	if Update() {
+		testRepo := newTestRepository(t, s.RemoteURL, withLFS())
+		if s.LFSEndpoint != "" {
+			testRepo.SetLFSEndpoint(s.LFSEndpoint)
+		}
+
+		testRepo.CreateBranch(branchName)
+		testRepo.CheckoutBranch(branchName)
+		testRepo.TrackLFS("assets/*.bin")
+		testRepo.WriteLFSFile(lfsPath, 1<<20) // 1 MiB, large enough to exercise the clean filter.
+		hash := testRepo.AddAllAndCommit("add LFS-tracked asset")
+		testRepo.Push(branchName)
+		setCommitHash(hash.String())
+
+		t.Cleanup(func() {
+			testRepo.DeleteRemoteBranch(branchName)
+		})
+	}
+	commitHash := commitHashFixture.Get(t)
+
+	repo := s.OpenRepository(t)
+
+	change, err := repo.SubmitChange(t.Context(), forge.SubmitChangeRequest{
+		Subject: "Testing LFS glob tracking " + branchName,
+		Body:    "Test PR with an LFS-tracked asset directory",
+		Base:    s.Trunk,
+		Head:    branchName,
+	})
+	require.NoError(t, err, "error creating PR")
+
+	foundChange, err := repo.FindChangeByID(t.Context(), change.ID)
+	require.NoError(t, err, "error finding change by ID")
+	assert.Equal(t, commitHash, foundChange.HeadHash.String(),
+		"head hash should match the pushed LFS commit")
+
+	content, err := repo.ReadFile(t.Context(), branchName, lfsPath)
+	require.NoError(t, err, "error reading LFS-tracked file")
+	assert.Contains(t, string(content), "version https://git-lfs.github.com/spec/v1",
+		"file content should be the LFS pointer, not the raw blob")
+}
+
+// TestSubmitSignedCommits submits a change whose branch contains a
+// stack of two GPG-signed commits, and verifies that the forge
+// reports the head commit as having a verified signature.
+func (s *integrationSuite) TestSubmitSignedCommits(t *testing.T) {
+	if _, err := exec.LookPath("gpg"); err != nil {
+		t.Skip("gpg is not installed")
+	}
+
+	branchFixture := fixturetest.New(s.Fixtures, "branch", func() string {
+		return randomString(8)
+	})
+	commitHashFixture, setCommitHash := fixturetest.Stored[string](s.Fixtures, "commitHash")
+
+	branchName := branchFixture.Get(t)
+	t.Logf("Creating branch: %s", branchName)
+
+	if Update() {
+		gpgHome := t.TempDir()
+		keyID := generateGPGKey(t, gpgHome)
+
+		testRepo := newTestRepositoryWithSigning(t, s.RemoteURL, SigningOptions{GPGKey: keyID})
+		testRepo.CreateBranch(branchName)
+		testRepo.CheckoutBranch(branchName)
+
+		testRepo.WriteFile(branchName+"-1.txt", randomString(32))
+		testRepo.AddAllAndCommit("first signed commit")
+
+		testRepo.WriteFile(branchName+"-2.txt", randomString(32))
+		hash := testRepo.AddAllAndCommit("second signed commit")
+
+		testRepo.Push(branchName)
+		setCommitHash(hash.String())
+
+		t.Cleanup(func() {
+			testRepo.DeleteRemoteBranch(branchName)
+		})
+	}
+	commitHash := commitHashFixture.Get(t)
+
+	repo := s.OpenRepository(t)
+
+	change, err := repo.SubmitChange(t.Context(), forge.SubmitChangeRequest{
+		Subject: "Testing signed commits " + branchName,
+		Body:    "Test PR with a stack of signed commits",
+		Base:    s.Trunk,
+		Head:    branchName,
+	})
+	require.NoError(t, err, "error creating PR")
+
+	foundChange, err := repo.FindChangeByID(t.Context(), change.ID)
+	require.NoError(t, err, "error finding change by ID")
+	assert.Equal(t, commitHash, foundChange.HeadHash.String(),
+		"head hash should match the pushed signed commit")
+	assert.True(t, foundChange.Verified,
+		"head commit should be reported as having a verified signature")
+}
+
+// TestSubmitChangeSHA256 submits a change from a repository cloned
+// with the SHA-256 object format, and verifies that the forge
+// correctly reports the head hash as a 64-character SHA-256 digest
+// rather than a 40-character SHA-1 one.
+//
+// The test is skipped if the remote test repository doesn't actually
+// advertise SHA-256 support, even if [IntegrationConfig.SupportsSHA256]
+// is set.
+func (s *integrationSuite) TestSubmitChangeSHA256(t *testing.T) {
+	branchFixture := fixturetest.New(s.Fixtures, "branch", func() string {
+		return randomString(8)
+	})
+	commitHashFixture, setCommitHash := fixturetest.Stored[string](s.Fixtures, "commitHash")
+
+	branchName := branchFixture.Get(t)
+	t.Logf("Creating branch: %s", branchName)
+
+	if Update() {
+		testRepo := newTestRepository(t, s.RemoteURL, withObjectFormat("sha256"))
+
+		testRepo.CreateBranch(branchName)
+		testRepo.CheckoutBranch(branchName)
+		testRepo.WriteFile(branchName+".txt", randomString(32))
+		hash := testRepo.AddAllAndCommit("commit from test")
+		testRepo.Push(branchName)
+		setCommitHash(hash.String())
+
+		t.Cleanup(func() {
+			testRepo.DeleteRemoteBranch(branchName)
+		})
+	}
+	commitHash := commitHashFixture.Get(t)
+
+	repo := s.OpenRepository(t)
+
+	change, err := repo.SubmitChange(t.Context(), forge.SubmitChangeRequest{
+		Subject: "Testing SHA-256 " + branchName,
+		Body:    "Test PR against a SHA-256 repository",
+		Base:    s.Trunk,
+		Head:    branchName,
+	})
+	require.NoError(t, err, "error creating PR")
+
+	foundChange, err := repo.FindChangeByID(t.Context(), change.ID)
+	require.NoError(t, err, "error finding change by ID")
+	assert.Len(t, foundChange.HeadHash.String(), 64,
+		"head hash should be a 64-character SHA-256 digest")
+	assert.Equal(t, commitHash, foundChange.HeadHash.String(),
+		"head hash should match the pushed commit")
+}
+
+// TestSubmitDoesNotTouchInternalRefs submits a change, then attempts
+// to force-push and delete the forge's internal ref for that change.
+// Both operations must be rejected by the remote, and the change's
+// reported head hash must remain unaffected.
+func (s *integrationSuite) TestSubmitDoesNotTouchInternalRefs(t *testing.T) {
+	branchFixture := fixturetest.New(s.Fixtures, "branch", func() string {
+		return randomString(8)
+	})
+	commitHashFixture, setCommitHash := fixturetest.Stored[string](s.Fixtures, "commitHash")
+
+	branchName := branchFixture.Get(t)
+	t.Logf("Creating branch: %s", branchName)
+
+	if Update() {
+		testRepo := newTestRepository(t, s.RemoteURL)
+
+		testRepo.CreateBranch(branchName)
+		testRepo.CheckoutBranch(branchName)
+		testRepo.WriteFile(branchName+".txt", randomString(32))
+		hash := testRepo.AddAllAndCommit("commit from test")
+		testRepo.Push(branchName)
+		setCommitHash(hash.String())
+
+		t.Cleanup(func() {
+			testRepo.DeleteRemoteBranch(branchName)
+		})
+	}
+	commitHash := commitHashFixture.Get(t)
+
+	repo := s.OpenRepository(t)
+
+	change, err := repo.SubmitChange(t.Context(), forge.SubmitChangeRequest{
+		Subject: "Testing " + branchName,
+		Body:    "Test PR",
+		Base:    s.Trunk,
+		Head:    branchName,
+	})
+	require.NoError(t, err, "error creating PR")
+
+	internalRef := internalRefName(s.InternalRefPattern, change.ID)
+
+	if Update() {
+		testRepo := newTestRepository(t, s.RemoteURL)
+
+		err := testRepo.ForcePush(s.Trunk + ":" + internalRef)
+		assert.Error(t, err, "force-pushing %s should be rejected", internalRef)
+
+		err = testRepo.ForcePush(":" + internalRef)
+		assert.Error(t, err, "deleting %s should be rejected", internalRef)
+	}
+
+	foundChange, err := repo.FindChangeByID(t.Context(), change.ID)
+	require.NoError(t, err, "error finding change by ID")
+	assert.Equal(t, commitHash, foundChange.HeadHash.String(),
+		"internal ref should still resolve to the original commit")
+}
+
+// internalRefName fills in a [IntegrationConfig.InternalRefPattern]
+// template with a change's numeric ID.
+func internalRefName(pattern string, id forge.ChangeID) string {
+	numericID := strings.TrimLeft(id.String(), "#!")
+	return strings.ReplaceAll(pattern, "{id}", numericID)
+}
+
 // testRepository manages a local Git repository clone for testing.
 // Only available in update mode.
 type testRepository struct {
@@ -1272,17 +1911,73 @@ type testRepository struct {
 	work *git.Worktree
 	root string
 	t    *testing.T
+
+	// sign indicates that commits made with AddAllAndCommit
+	// should be signed.
+	sign bool
+}
+
+// testRepoOption configures [newTestRepository].
+type testRepoOption func(*testRepoOptions)
+
+type testRepoOptions struct {
+	lfs          bool
+	objectFormat string
+}
+
+// withObjectFormat configures the cloned repository to use the given
+// Git object hash algorithm, e.g. "sha1" or "sha256".
+//
+// The test is skipped if the installed git does not support cloning
+// with the requested object format.
+func withObjectFormat(format string) testRepoOption {
+	return func(o *testRepoOptions) { o.objectFormat = format }
 }
 
-func newTestRepository(t *testing.T, remoteURL string) *testRepository {
+// withLFS configures the cloned repository for Git LFS.
+// The test is skipped if the git-lfs binary isn't installed.
+func withLFS() testRepoOption {
+	return func(o *testRepoOptions) { o.lfs = true }
+}
+
+func newTestRepository(t *testing.T, remoteURL string, opts ...testRepoOption) *testRepository {
 	require.True(t, Update(), "testRepository only available in update mode")
 
+	var cfg testRepoOptions
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if cfg.lfs {
+		if _, err := exec.LookPath("git-lfs"); err != nil {
+			t.Skip("git-lfs is not installed")
+		}
+	}
+
 	repoDir := t.TempDir()
 	output := t.Output()
-	cmd := xec.Command(t.Context(), silogtest.New(t), "git", "clone", remoteURL, repoDir).
+	cloneArgs := []string{"clone"}
+	if cfg.objectFormat != "" {
+		cloneArgs = append(cloneArgs, "--object-format="+cfg.objectFormat)
+	}
+	cloneArgs = append(cloneArgs, remoteURL, repoDir)
+	cmd := xec.Command(t.Context(), silogtest.New(t), "git", cloneArgs...).
 		WithStdout(output).
 		WithStderr(output)
-	require.NoError(t, cmd.Run(), "failed to clone repository")
+	if err := cmd.Run(); err != nil {
+		if cfg.objectFormat != "" {
+			t.Skipf("clone with --object-format=%s not supported here: %v", cfg.objectFormat, err)
+		}
+		require.NoError(t, err, "failed to clone repository")
+	}
+
+	if cfg.lfs {
+		lfsCmd := xec.Command(t.Context(), silogtest.New(t), "git", "lfs", "install", "--local").
+			WithDir(repoDir).
+			WithStdout(output).
+			WithStderr(output)
+		require.NoError(t, lfsCmd.Run(), "failed to install git-lfs")
+	}
 
 	ctx := t.Context()
 	work, err := git.OpenWorktree(ctx, repoDir, git.OpenOptions{
@@ -1298,6 +1993,111 @@ func newTestRepository(t *testing.T, remoteURL string) *testRepository {
 	}
 }
 
+// SetLFSEndpoint overrides the Git LFS batch API endpoint
+// used by this repository.
+func (r *testRepository) SetLFSEndpoint(endpoint string) {
+	cmd := xec.Command(r.t.Context(), silogtest.New(r.t), "git", "config", "lfs.url", endpoint).
+		WithDir(r.root).
+		WithStdout(r.t.Output()).
+		WithStderr(r.t.Output())
+	require.NoError(r.t, cmd.Run(), "failed to configure LFS endpoint")
+}
+
+// SigningOptions selects the key material that
+// [newTestRepositoryWithSigning] configures a repository to sign
+// commits with. Exactly one of GPGKey or SSHKey must be set.
+type SigningOptions struct {
+	// GPGKey is the key ID of an already-imported GPG key
+	// to sign commits with.
+	GPGKey string
+
+	// SSHKey is the path to an SSH private key
+	// to sign commits with, using Git's SSH signing format.
+	SSHKey string
+}
+
+// newTestRepositoryWithSigning is like [newTestRepository],
+// but configures the clone to sign commits with the key described by
+// opts, setting user.signingkey, gpg.format, and commit.gpgsign.
+//
+// Commits made with AddAllAndCommit on the returned repository
+// will be signed.
+func newTestRepositoryWithSigning(t *testing.T, remoteURL string, opts SigningOptions) *testRepository {
+	require.True(t, opts.GPGKey != "" || opts.SSHKey != "",
+		"SigningOptions must set GPGKey or SSHKey")
+
+	testRepo := newTestRepository(t, remoteURL)
+	testRepo.sign = true
+
+	configs := [][2]string{
+		{"commit.gpgsign", "true"},
+	}
+	if opts.SSHKey != "" {
+		configs = append(configs,
+			[2]string{"gpg.format", "ssh"},
+			[2]string{"user.signingkey", opts.SSHKey},
+		)
+	} else {
+		configs = append(configs,
+			[2]string{"gpg.format", "openpgp"},
+			[2]string{"user.signingkey", opts.GPGKey},
+		)
+	}
+
+	output := t.Output()
+	for _, kv := range configs {
+		cmd := xec.Command(t.Context(), silogtest.New(t), "git", "config", kv[0], kv[1]).
+			WithDir(testRepo.root).
+			WithStdout(output).
+			WithStderr(output)
+		require.NoError(t, cmd.Run(), "failed to configure %s", kv[0])
+	}
+
+	return testRepo
+}
+
+// generateGPGKey creates a new, passphrase-less GPG key under home
+// (used as GNUPGHOME) and returns its key ID.
+//
+// The test is skipped if the gpg binary isn't installed.
+func generateGPGKey(t *testing.T, home string) string {
+	if _, err := exec.LookPath("gpg"); err != nil {
+		t.Skip("gpg is not installed")
+	}
+	t.Setenv("GNUPGHOME", home)
+
+	paramsPath := filepath.Join(home, "gen-key.params")
+	require.NoError(t, os.WriteFile(paramsPath, []byte(
+		"%no-protection\n"+
+			"Key-Type: RSA\n"+
+			"Key-Length: 2048\n"+
+			"Name-Real: gs-test[bot]\n"+
+			"Name-Email: bot@example.com\n"+
+			"Expire-Date: 1d\n"+
+			"%commit\n",
+	), 0o600), "failed to write gpg key params")
+
+	output := t.Output()
+	genCmd := xec.Command(t.Context(), silogtest.New(t), "gpg", "--batch", "--gen-key", paramsPath).
+		WithStdout(output).
+		WithStderr(output)
+	require.NoError(t, genCmd.Run(), "failed to generate gpg key")
+
+	listCmd := xec.Command(t.Context(), silogtest.New(t), "gpg", "--list-secret-keys", "--with-colons")
+	out, err := listCmd.Output()
+	require.NoError(t, err, "failed to list gpg keys")
+
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Split(line, ":")
+		if len(fields) > 9 && fields[0] == "fpr" {
+			return fields[9]
+		}
+	}
+
+	t.Fatal("could not find generated gpg key fingerprint")
+	return ""
+}
+
 func (r *testRepository) ctx() context.Context {
 	ctx := r.t.Context()
 	// If the context was canceled, ignore its cancellation.
@@ -1324,6 +2124,47 @@ func (r *testRepository) WriteFile(path string, lines ...string) {
 	), "could not write file: %s", path)
 }
 
+// WriteLFSFile writes a file containing random bytes of the given
+// size, for use with a Git LFS tracked path.
+func (r *testRepository) WriteLFSFile(path string, size int) {
+	content := make([]byte, size)
+	_, err := rand.Read(content)
+	require.NoError(r.t, err, "could not generate random content")
+
+	require.NoError(r.t, os.MkdirAll(
+		filepath.Dir(filepath.Join(r.root, path)),
+		0o755,
+	), "could not create directories for file: %s", path)
+	require.NoError(r.t, os.WriteFile(
+		filepath.Join(r.root, path),
+		content,
+		0o644,
+	), "could not write file: %s", path)
+}
+
+// TrackLFS runs "git lfs track" for the given patterns, e.g.
+// "*.bin", and stages the resulting .gitattributes file.
+//
+// The test is skipped if the git-lfs binary isn't installed.
+func (r *testRepository) TrackLFS(patterns ...string) {
+	if _, err := exec.LookPath("git-lfs"); err != nil {
+		r.t.Skip("git-lfs is not installed")
+	}
+
+	output := r.t.Output()
+	cmd := xec.Command(r.t.Context(), silogtest.New(r.t), "git", append([]string{"lfs", "track"}, patterns...)...).
+		WithDir(r.root).
+		WithStdout(output).
+		WithStderr(output)
+	require.NoError(r.t, cmd.Run(), "git lfs track failed for %v", patterns)
+
+	addCmd := xec.Command(r.t.Context(), silogtest.New(r.t), "git", "add", ".gitattributes").
+		WithDir(r.root).
+		WithStdout(output).
+		WithStderr(output)
+	require.NoError(r.t, addCmd.Run(), "could not stage .gitattributes")
+}
+
 // AddAllAndCommit stages all changes and creates a commit.
 func (r *testRepository) AddAllAndCommit(message string) git.Hash {
 	output := r.t.Output()
@@ -1342,6 +2183,7 @@ func (r *testRepository) AddAllAndCommit(message string) git.Hash {
 		Message:   message,
 		Author:    &sig,
 		Committer: &sig,
+		Sign:      r.sign,
 	}), "could not commit changes")
 
 	hash, err := r.repo.PeelToCommit(ctx, "HEAD")
@@ -1373,6 +2215,19 @@ func (r *testRepository) Push(refspec string) {
 	}), "error pushing refspec: %s", refspec)
 }
 
+// ForcePush force-pushes the given refspec to origin,
+// returning the error instead of failing the test.
+//
+// This is meant for tests that expect the push to be rejected.
+func (r *testRepository) ForcePush(refspec string) error {
+	ctx := r.ctx()
+	return r.work.Push(ctx, git.PushOptions{
+		Remote:  "origin",
+		Refspec: git.Refspec(refspec),
+		Force:   true,
+	})
+}
+
 // DeleteRemoteBranch deletes a remote branch.
 func (r *testRepository) DeleteRemoteBranch(name string) {
 	ctx := r.ctx()
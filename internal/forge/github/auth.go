@@ -1,17 +1,18 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net/url"
-	"os"
+	"os/exec"
 	"strings"
 
-	"github.com/shurcooL/githubv4"
 	"go.abhg.dev/gs/internal/forge"
 	"go.abhg.dev/gs/internal/secret"
+	"go.abhg.dev/gs/internal/text"
 	"go.abhg.dev/gs/internal/ui"
 	"golang.org/x/oauth2"
 )
@@ -26,18 +27,25 @@ const (
 type AuthenticationToken struct {
 	forge.AuthenticationToken
 
-	AccessToken string
+	// AccessToken is the GitHub access token.
+	//
+	// Not used if GitHubCLI is true.
+	AccessToken string `json:"access_token,omitempty"`
+
+	// GitHubCLI indicates that the token should be sourced
+	// from the GitHub CLI at request time,
+	// instead of being stored directly.
+	GitHubCLI bool `json:"github_cli,omitempty"`
 }
 
-func (t *AuthenticationToken) githubv4Client(ctx context.Context, apiURL string) (*githubv4.Client, error) {
-	graphQLAPIURL, err := url.JoinPath(apiURL, "/graphql")
-	if err != nil {
-		return nil, fmt.Errorf("build GraphQL API URL: %w", err)
-	}
+var _ forge.AuthenticationToken = (*AuthenticationToken)(nil)
 
-	tokenSource := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: t.AccessToken})
-	httpClient := oauth2.NewClient(ctx, tokenSource)
-	return githubv4.NewEnterpriseClient(graphQLAPIURL, httpClient), nil
+// tokenSource builds an oauth2.TokenSource for this token.
+func (t *AuthenticationToken) tokenSource() oauth2.TokenSource {
+	if t.GitHubCLI {
+		return new(CLITokenSource)
+	}
+	return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: t.AccessToken})
 }
 
 func (f *Forge) oauth2Endpoint() (oauth2.Endpoint, error) {
@@ -56,15 +64,16 @@ func (f *Forge) oauth2Endpoint() (oauth2.Endpoint, error) {
 // AuthenticationFlow prompts the user to authenticate with GitHub.
 // This rejects the request if the user is already authenticated
 // with a GITHUB_TOKEN environment variable.
-func (f *Forge) AuthenticationFlow(ctx context.Context) (forge.AuthenticationToken, error) {
+func (f *Forge) AuthenticationFlow(ctx context.Context, view ui.View) (forge.AuthenticationToken, error) {
+	log := f.logger()
 	// Already authenticated with GITHUB_TOKEN.
 	// If the user tries to authenticate again, we should error.
 	if f.Options.Token != "" {
 		// NB: alternatively, we can make this a no-op,
 		// and just omit saving it to the stash.
 		// Adjust based on user feedback.
-		f.Log.Error("Already authenticated with GITHUB_TOKEN.")
-		f.Log.Error("Unset GITHUB_TOKEN to login with a different method.")
+		log.Error("Already authenticated with GITHUB_TOKEN.")
+		log.Error("Unset GITHUB_TOKEN to login with a different method.")
 		return nil, errors.New("already authenticated")
 	}
 
@@ -73,22 +82,38 @@ func (f *Forge) AuthenticationFlow(ctx context.Context) (forge.AuthenticationTok
 		return nil, fmt.Errorf("get OAuth endpoint: %w", err)
 	}
 
-	return (&githubAuthenticator{
+	auth, err := selectAuthenticator(view, authenticatorOptions{
 		Endpoint: oauthEndpoint,
-		Stdin:    os.Stdin,
-		Stderr:   os.Stderr,
-	}).Authenticate(ctx)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("select authenticator: %w", err)
+	}
+
+	tok, err := auth.Authenticate(ctx, view)
+	if err != nil {
+		return nil, err
+	}
+
+	f.offerSSHKeySetup(ctx, view, tok)
+
+	return tok, nil
 }
 
 // SaveAuthenticationToken saves the given authentication token to the stash.
 func (f *Forge) SaveAuthenticationToken(stash secret.Stash, t forge.AuthenticationToken) error {
-	tok := t.(*AuthenticationToken).AccessToken
-	if f.Options.Token != "" && f.Options.Token == tok {
+	ght := t.(*AuthenticationToken)
+	if f.Options.Token != "" && f.Options.Token == ght.AccessToken {
 		// If the user has set GITHUB_TOKEN,
 		// we should not save it to the stash.
 		return nil
 	}
-	return stash.SaveSecret(f.URL(), "token", tok)
+
+	bs, err := json.Marshal(ght)
+	if err != nil {
+		return fmt.Errorf("marshal token: %w", err)
+	}
+
+	return stash.SaveSecret(f.URL(), "token", string(bs))
 }
 
 // LoadAuthenticationToken loads the authentication token from the stash.
@@ -100,12 +125,19 @@ func (f *Forge) LoadAuthenticationToken(stash secret.Stash) (forge.Authenticatio
 		return &AuthenticationToken{AccessToken: f.Options.Token}, nil
 	}
 
-	tok, err := stash.LoadSecret(f.URL(), "token")
+	tokstr, err := stash.LoadSecret(f.URL(), "token")
 	if err != nil {
 		return nil, fmt.Errorf("load token: %w", err)
 	}
 
-	return &AuthenticationToken{AccessToken: tok}, nil
+	var tok AuthenticationToken
+	if err := json.Unmarshal([]byte(tokstr), &tok); err != nil {
+		// Older versions of git-spice stored the bare access token
+		// as the secret, without any wrapping structure.
+		return &AuthenticationToken{AccessToken: tokstr}, nil
+	}
+
+	return &tok, nil
 }
 
 // ClearAuthenticationToken removes the authentication token from the stash.
@@ -113,93 +145,129 @@ func (f *Forge) ClearAuthenticationToken(stash secret.Stash) error {
 	return stash.DeleteSecret(f.URL(), "token")
 }
 
-type authenticationMethod func(context.Context) (forge.AuthenticationToken, error)
-
-// githubAuthenticator presents the user with multiple authentication methods,
-// prompts them to choose one, and executes the chosen method.
-type githubAuthenticator struct {
-	Endpoint oauth2.Endpoint
-	Stdin    io.Reader
-	Stderr   io.Writer
+type authenticator interface {
+	Authenticate(context.Context, ui.View) (*AuthenticationToken, error)
 }
 
-func (a *githubAuthenticator) Authenticate(ctx context.Context) (forge.AuthenticationToken, error) {
-	methods := []ui.ListItem[authenticationMethod]{
-		{
-			Title:       "OAuth",
-			Description: _oauthDesc,
-			Value: (&DeviceFlowAuthenticator{
-				Endpoint: a.Endpoint,
-				Stderr:   a.Stderr,
+var _authenticationMethods = []struct {
+	Title       string
+	Description func(focused bool) string
+	Build       func(authenticatorOptions) authenticator
+}{
+	{
+		Title:       "OAuth",
+		Description: oauthDesc,
+		Build: func(a authenticatorOptions) authenticator {
+			return &DeviceFlowAuthenticator{
 				ClientID: _oauthAppClientID,
+				Endpoint: a.Endpoint,
 				Scopes:   []string{"repo"},
-			}).Authenticate,
+			}
 		},
-		{
-			Title:       "OAuth: Public repositories only",
-			Description: _oauthPublicDesc,
-			Value: (&DeviceFlowAuthenticator{
-				Endpoint: a.Endpoint,
-				Stderr:   a.Stderr,
+	},
+	{
+		Title:       "OAuth: Public repositories only",
+		Description: oauthPublicDesc,
+		Build: func(a authenticatorOptions) authenticator {
+			return &DeviceFlowAuthenticator{
 				ClientID: _oauthAppClientID,
+				Endpoint: a.Endpoint,
 				Scopes:   []string{"public_repo"},
-			}).Authenticate,
+			}
 		},
-		{
-			Title:       "GitHub App",
-			Description: _githubAppDesc,
-			Value: (&DeviceFlowAuthenticator{
-				Endpoint: a.Endpoint,
-				Stderr:   a.Stderr,
+	},
+	{
+		Title:       "GitHub App",
+		Description: githubAppDesc,
+		Build: func(a authenticatorOptions) authenticator {
+			return &DeviceFlowAuthenticator{
 				ClientID: _githubAppClientID,
+				Endpoint: a.Endpoint,
 				// No scopes needed for GitHub App.
-			}).Authenticate,
+			}
+		},
+	},
+	{
+		Title:       "Personal Access Token",
+		Description: patDesc,
+		Build: func(authenticatorOptions) authenticator {
+			return &PATAuthenticator{}
 		},
-		{
-			Title:       "Personal Access Token",
-			Description: _patDesc,
-			Value: (&PATAuthenticator{
-				Stdin:  a.Stdin,
-				Stderr: a.Stderr,
-			}).Authenticate,
+	},
+	{
+		Title:       "GitHub CLI",
+		Description: ghDesc,
+		Build: func(authenticatorOptions) authenticator {
+			return &CLIAuthenticator{}
 		},
+	},
+}
+
+// authenticatorOptions carries the parameters needed to build
+// any of the available GitHub authenticators.
+type authenticatorOptions struct {
+	Endpoint oauth2.Endpoint // required
+}
+
+func selectAuthenticator(view ui.View, a authenticatorOptions) (authenticator, error) {
+	var methods []ui.ListItem[authenticator]
+	for _, m := range _authenticationMethods {
+		methods = append(methods, ui.ListItem[authenticator]{
+			Title:       m.Title,
+			Description: m.Description,
+			Value:       m.Build(a),
+		})
 	}
 
-	var method authenticationMethod
-	field := ui.NewList[authenticationMethod]().
+	var method authenticator
+	field := ui.NewList[authenticator]().
 		WithTitle("Select an authentication method").
 		WithItems(methods...).
 		WithValue(&method)
-	err := ui.Run(field, ui.WithInput(a.Stdin), ui.WithOutput(a.Stderr))
-	if err != nil {
-		return nil, err
-	}
+	err := ui.Run(view, field)
+	return method, err
+}
+
+func oauthDesc(bool) string {
+	return text.Dedent(`
+		Authorize git-spice to act on your behalf from this device only.
+		git-spice will get access to all repositories: public and private.
+		For private repositories, you will need to request installation from a repository owner.
+	`)
+}
+
+func oauthPublicDesc(bool) string {
+	return text.Dedent(`
+		Authorize git-spice to act on your behalf from this device only.
+		git-spice will only get access to public repositories.
+	`)
+}
+
+func githubAppDesc(bool) string {
+	return text.Dedent(`
+		Authorize git-spice to act on your behalf from this device only.
+		git-spice will only get access to repositories where the git-spice GitHub App is installed explicitly.
+		Use https://github.com/apps/git-spice to install the App on repositories.
+		For private repositories, you will need to request installation from a repository owner.
+	`)
+}
 
-	return method(ctx)
+func patDesc(bool) string {
+	return text.Dedent(`
+		Enter a classic or fine-grained Personal Access Token generated from https://github.com/settings/tokens.
+		Classic tokens need at least one of the following scopes: repo or public_repo.
+		Fine-grained tokens need read/write access to Repository Contents and Pull requests.
+		You can use this method if you do not have the ability to install a GitHub or OAuth App on your repositories.
+	`)
 }
 
-var _oauthDesc = strings.TrimSpace(`
-Authorize git-spice to act on your behalf from this device only.
-git-spice will get access to all repositories: public and private.
-`)
-
-var _oauthPublicDesc = strings.TrimSpace(`
-Authorize git-spice to act on your behalf from this device only.
-git-spice will only get access to public repositories.
-`)
-
-var _githubAppDesc = strings.TrimSpace(`
-Authorize git-spice to act on your behalf from this device only.
-git-spice will only get access to repositories where the git-spice GitHub App is installed explicitly.
-Use https://github.com/apps/git-spice to install the App on repositories.
-`)
-
-var _patDesc = strings.TrimSpace(`
-Enter a classic or fine-grained Personal Access Token generated from https://github.com/settings/tokens.
-Classic tokens need at least one of the following scopes: repo or public_repo.
-Fine-grained tokens need read/write access to Repository Contents and Pull requests.
-You can use this method if you do not have the ability to install a GitHub or OAuth App on your repositories.
-`)
+func ghDesc(bool) string {
+	return text.Dedent(`
+		Re-use an existing GitHub CLI (https://cli.github.com) session.
+		You must be logged into gh with 'gh auth login' for this to work.
+		You can use this if you're just experimenting and don't want to set up a token yet.
+	`)
+}
 
 // DeviceFlowAuthenticator implements the OAuth device flow for GitHub.
 // This is used for OAuth and GitHub App authentication.
@@ -212,12 +280,12 @@ type DeviceFlowAuthenticator struct {
 
 	// Scopes specifies the OAuth scopes to request.
 	Scopes []string
-
-	Stderr io.Writer
 }
 
+var _ authenticator = (*DeviceFlowAuthenticator)(nil)
+
 // Authenticate executes the OAuth authentication flow.
-func (a *DeviceFlowAuthenticator) Authenticate(ctx context.Context) (forge.AuthenticationToken, error) {
+func (a *DeviceFlowAuthenticator) Authenticate(ctx context.Context, view ui.View) (*AuthenticationToken, error) {
 	cfg := oauth2.Config{
 		ClientID:    a.ClientID,
 		Endpoint:    a.Endpoint,
@@ -235,10 +303,10 @@ func (a *DeviceFlowAuthenticator) Authenticate(ctx context.Context) (forge.Authe
 	bullet := ui.NewStyle().PaddingLeft(2).Foreground(ui.Gray)
 	faint := ui.NewStyle().Faint(true)
 
-	fmt.Fprintf(a.Stderr, "%s Visit %s\n", bullet.Render("1."), urlStle.Render(resp.VerificationURI))
-	fmt.Fprintf(a.Stderr, "%s Enter code: %s\n", bullet.Render("2."), codeStyle.Render(resp.UserCode))
-	fmt.Fprintln(a.Stderr, faint.Render("The code expires in a few minutes."))
-	fmt.Fprintln(a.Stderr, faint.Render("It will take a few seconds to verify after you enter it."))
+	fmt.Fprintf(view, "%s Visit %s\n", bullet.Render("1."), urlStle.Render(resp.VerificationURI))
+	fmt.Fprintf(view, "%s Enter code: %s\n", bullet.Render("2."), codeStyle.Render(resp.UserCode))
+	fmt.Fprintln(view, faint.Render("The code expires in a few minutes."))
+	fmt.Fprintln(view, faint.Render("It will take a few seconds to verify after you enter it."))
 	// TODO: maybe open browser with flag opt-out
 
 	token, err := cfg.DeviceAccessToken(ctx, resp,
@@ -251,16 +319,15 @@ func (a *DeviceFlowAuthenticator) Authenticate(ctx context.Context) (forge.Authe
 }
 
 // PATAuthenticator implements PAT authentication for GitHub.
-type PATAuthenticator struct {
-	Stdin  io.Reader
-	Stderr io.Writer
-}
+type PATAuthenticator struct{}
+
+var _ authenticator = (*PATAuthenticator)(nil)
 
 // Authenticate prompts the user for a Personal Access Token,
 // validates it, and returns the token if successful.
-func (a *PATAuthenticator) Authenticate(ctx context.Context) (forge.AuthenticationToken, error) {
+func (a *PATAuthenticator) Authenticate(_ context.Context, view ui.View) (*AuthenticationToken, error) {
 	var token string
-	err := ui.Run(ui.NewInput().
+	err := ui.Run(view, ui.NewInput().
 		WithTitle("Enter Personal Access Token").
 		WithValidate(func(input string) error {
 			if strings.TrimSpace(input) == "" {
@@ -268,8 +335,6 @@ func (a *PATAuthenticator) Authenticate(ctx context.Context) (forge.Authenticati
 			}
 			return nil
 		}).WithValue(&token),
-		ui.WithInput(a.Stdin),
-		ui.WithOutput(a.Stderr),
 	)
 	if err != nil {
 		return nil, err
@@ -278,3 +343,45 @@ func (a *PATAuthenticator) Authenticate(ctx context.Context) (forge.Authenticati
 	// TODO: Should we validate the token by making a request?
 	return &AuthenticationToken{AccessToken: token}, nil
 }
+
+// CLIAuthenticator implements GitHub CLI authentication flow.
+// This doesn't do anything special besides checking if the user is logged in.
+type CLIAuthenticator struct {
+	// GH is the path to, or name of, the GitHub CLI executable.
+	//
+	// Defaults to "gh".
+	GH string
+
+	runCmd func(*exec.Cmd) error // for testing
+}
+
+var _ authenticator = (*CLIAuthenticator)(nil)
+
+// Authenticate checks if the user is authenticated with the GitHub CLI.
+// The returned AuthenticationToken is saved to the stash,
+// and the actual access token is sourced from the CLI at request time.
+func (a *CLIAuthenticator) Authenticate(ctx context.Context, _ ui.View) (*AuthenticationToken, error) {
+	runCmd := a.runCmd
+	if runCmd == nil {
+		runCmd = (*exec.Cmd).Run
+	}
+
+	gh := a.GH
+	if gh == "" {
+		gh = "gh"
+	}
+
+	cmd := exec.CommandContext(ctx, gh, "auth", "status")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := runCmd(cmd); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return nil, fmt.Errorf("gh is not authenticated: %s", stderr.String())
+		}
+
+		return nil, fmt.Errorf("gh auth status: %w", err)
+	}
+
+	return &AuthenticationToken{GitHubCLI: true}, nil
+}
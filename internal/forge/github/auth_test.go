@@ -356,10 +356,12 @@ func TestAuthCLI(t *testing.T) {
 	t.Run("unauthenticated", func(t *testing.T) {
 		_, err := (&CLIAuthenticator{
 			GH: "gh",
-			runCmd: func(*exec.Cmd) error {
-				return &exec.ExitError{
-					Stderr: []byte("great sadness"),
-				}
+			runCmd: func(cmd *exec.Cmd) error {
+				// Real command execution writes to cmd.Stderr
+				// directly; it doesn't populate ExitError.Stderr
+				// unless the caller used Output/CombinedOutput.
+				_, _ = cmd.Stderr.Write([]byte("great sadness"))
+				return &exec.ExitError{}
 			},
 		}).Authenticate(t.Context(), discardView)
 		require.Error(t, err)
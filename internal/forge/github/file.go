@@ -0,0 +1,36 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shurcooL/githubv4"
+)
+
+// ReadFile reads the contents of a file at the given Git ref.
+func (r *Repository) ReadFile(ctx context.Context, ref, path string) ([]byte, error) {
+	var q struct {
+		Repository struct {
+			Object struct {
+				Blob struct {
+					Text     githubv4.String `graphql:"text"`
+					IsBinary bool            `graphql:"isBinary"`
+				} `graphql:"... on Blob"`
+			} `graphql:"object(expression: $expr)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	if err := r.client.Query(ctx, &q, map[string]any{
+		"owner": githubv4.String(r.owner),
+		"name":  githubv4.String(r.repo),
+		"expr":  githubv4.String(ref + ":" + path),
+	}); err != nil {
+		return nil, fmt.Errorf("read %q at %q: %w", path, ref, err)
+	}
+
+	if q.Repository.Object.Blob.IsBinary {
+		return nil, fmt.Errorf("read %q at %q: file is binary", path, ref)
+	}
+
+	return []byte(q.Repository.Object.Blob.Text), nil
+}
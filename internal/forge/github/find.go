@@ -18,9 +18,26 @@ type findPRNode struct {
 	HeadRefOid  githubv4.GitObjectID      `graphql:"headRefOid"`
 	BaseRefName githubv4.String           `graphql:"baseRefName"`
 	IsDraft     githubv4.Boolean          `graphql:"isDraft"`
+
+	Commits struct {
+		Nodes []struct {
+			Commit struct {
+				Signature *struct {
+					IsValid githubv4.Boolean `graphql:"isValid"`
+				} `graphql:"signature"`
+			} `graphql:"commit"`
+		} `graphql:"nodes"`
+	} `graphql:"commits(last: 1)"`
 }
 
 func (n *findPRNode) toFindChangeItem() *forge.FindChangeItem {
+	var verified bool
+	if commits := n.Commits.Nodes; len(commits) > 0 {
+		if sig := commits[0].Commit.Signature; sig != nil {
+			verified = bool(sig.IsValid)
+		}
+	}
+
 	return &forge.FindChangeItem{
 		ID: &PR{
 			Number: int(n.Number),
@@ -32,6 +49,7 @@ func (n *findPRNode) toFindChangeItem() *forge.FindChangeItem {
 		BaseName: string(n.BaseRefName),
 		HeadHash: git.Hash(n.HeadRefOid),
 		Draft:    bool(n.IsDraft),
+		Verified: verified,
 	}
 }
 
@@ -7,10 +7,12 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
 	"net/url"
 	"strings"
 
 	"github.com/shurcooL/githubv4"
+	"go.abhg.dev/gs/internal/correlation"
 	"go.abhg.dev/gs/internal/forge"
 	"go.abhg.dev/gs/internal/silog"
 	"golang.org/x/oauth2"
@@ -149,6 +151,11 @@ func newGitHubv4Client(ctx context.Context, apiURL string, tokenSource oauth2.To
 		return nil, fmt.Errorf("build GraphQL API URL: %w", err)
 	}
 
+	// Tag every outbound request with the invocation's correlation ID,
+	// so that server-side logs can be matched up with a user's bug report.
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{
+		Transport: correlation.Transport(nil),
+	})
 	httpClient := oauth2.NewClient(ctx, tokenSource)
 	return newGitHubEnterpriseClient(graphQLAPIURL, httpClient), nil
 }
@@ -124,9 +124,14 @@ func TestIntegration(t *testing.T) {
 		CloseChange: func(t *testing.T, repo forge.Repository, change forge.ChangeID) {
 			require.NoError(t, github.CloseChange(t.Context(), repo.(*github.Repository), change.(*github.PR)))
 		},
+		SubmitReview: func(t *testing.T, repo forge.Repository, change forge.ChangeID, req forge.SubmitReviewRequest) {
+			require.NoError(t, repo.SubmitReview(t.Context(), change, req))
+		},
 		SetCommentsPageSize: github.SetListChangeCommentsPageSize,
 		Reviewers:           []string{"abhinav-robot"},
 		Assignees:           []string{"abhinav-robot", "abhinav"},
+		SupportsLFS:         true,
+		InternalRefPattern:  "refs/pull/{id}/head",
 	})
 }
 
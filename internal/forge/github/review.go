@@ -0,0 +1,150 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/shurcooL/githubv4"
+	"go.abhg.dev/gs/internal/forge"
+)
+
+// SubmitReview leaves a review on a pull request
+// on behalf of the authenticated user.
+func (r *Repository) SubmitReview(
+	ctx context.Context,
+	id forge.ChangeID,
+	req forge.SubmitReviewRequest,
+) error {
+	event, err := reviewEvent(req.State)
+	if err != nil {
+		return err
+	}
+
+	gqlID, err := r.graphQLID(ctx, mustPR(id))
+	if err != nil {
+		return err
+	}
+
+	var m struct {
+		AddPullRequestReview struct {
+			ClientMutationID githubv4.String `graphql:"clientMutationId"`
+		} `graphql:"addPullRequestReview(input: $input)"`
+	}
+
+	input := githubv4.AddPullRequestReviewInput{
+		PullRequestID: gqlID,
+		Event:         &event,
+	}
+	if req.Body != "" {
+		input.Body = (*githubv4.String)(&req.Body)
+	}
+
+	if err := r.client.Mutate(ctx, &m, input, nil); err != nil {
+		return fmt.Errorf("submit review: %w", err)
+	}
+
+	return nil
+}
+
+func reviewEvent(state forge.ReviewState) (githubv4.PullRequestReviewEvent, error) {
+	switch state {
+	case forge.ReviewApproved:
+		return githubv4.PullRequestReviewEventApprove, nil
+	case forge.ReviewChangesRequested:
+		return githubv4.PullRequestReviewEventRequestChanges, nil
+	case forge.ReviewCommented:
+		return githubv4.PullRequestReviewEventComment, nil
+	default:
+		return "", fmt.Errorf("cannot submit a review with state %v", state)
+	}
+}
+
+// ListChangeReviews lists the reviews left on a pull request, oldest first.
+func (r *Repository) ListChangeReviews(
+	ctx context.Context,
+	id forge.ChangeID,
+) iter.Seq2[*forge.Review, error] {
+	gqlID, err := r.graphQLID(ctx, mustPR(id))
+	if err != nil {
+		return func(yield func(*forge.Review, error) bool) {
+			yield(nil, err)
+		}
+	}
+
+	return func(yield func(*forge.Review, error) bool) {
+		var q struct {
+			Node struct {
+				PullRequest struct {
+					Reviews struct {
+						PageInfo struct {
+							EndCursor   githubv4.String `graphql:"endCursor"`
+							HasNextPage bool            `graphql:"hasNextPage"`
+						} `graphql:"pageInfo"`
+
+						Nodes []struct {
+							Author struct {
+								Login string `graphql:"login"`
+							} `graphql:"author"`
+							State       githubv4.PullRequestReviewState `graphql:"state"`
+							Body        string                          `graphql:"body"`
+							SubmittedAt githubv4.DateTime               `graphql:"submittedAt"`
+						} `graphql:"nodes"`
+					} `graphql:"reviews(first: $first, after: $after)"`
+				} `graphql:"... on PullRequest"`
+			} `graphql:"node(id: $id)"`
+		}
+
+		variables := map[string]any{
+			"id":    gqlID,
+			"first": githubv4.Int(_listChangeCommentsPageSize),
+			"after": (*githubv4.String)(nil),
+		}
+
+		for pageNum := 1; true; pageNum++ {
+			if err := r.client.Query(ctx, &q, variables); err != nil {
+				yield(nil, fmt.Errorf("list reviews (page %d): %w", pageNum, err))
+				return
+			}
+
+			for _, node := range q.Node.PullRequest.Reviews.Nodes {
+				state, err := forgeReviewState(node.State)
+				if err != nil {
+					yield(nil, err)
+					return
+				}
+
+				review := &forge.Review{
+					Reviewer:    node.Author.Login,
+					State:       state,
+					Body:        node.Body,
+					SubmittedAt: node.SubmittedAt.Time,
+				}
+				if !yield(review, nil) {
+					return
+				}
+			}
+
+			if !q.Node.PullRequest.Reviews.PageInfo.HasNextPage {
+				return
+			}
+
+			variables["after"] = q.Node.PullRequest.Reviews.PageInfo.EndCursor
+		}
+	}
+}
+
+func forgeReviewState(state githubv4.PullRequestReviewState) (forge.ReviewState, error) {
+	switch state {
+	case githubv4.PullRequestReviewStateApproved:
+		return forge.ReviewApproved, nil
+	case githubv4.PullRequestReviewStateChangesRequested:
+		return forge.ReviewChangesRequested, nil
+	case githubv4.PullRequestReviewStateCommented:
+		return forge.ReviewCommented, nil
+	case githubv4.PullRequestReviewStateDismissed:
+		return forge.ReviewDismissed, nil
+	default:
+		return 0, fmt.Errorf("unknown review state: %v", state)
+	}
+}
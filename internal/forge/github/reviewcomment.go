@@ -0,0 +1,298 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/shurcooL/githubv4"
+	"go.abhg.dev/gs/internal/forge"
+)
+
+// PRReviewComment is a ChangeCommentID for a GitHub pull request review
+// comment.
+type PRReviewComment struct {
+	GQLID       githubv4.ID `json:"gqlID,omitempty"`
+	ThreadGQLID githubv4.ID `json:"threadGqlID,omitempty"`
+	URL         string      `json:"url,omitempty"`
+}
+
+var _ forge.ChangeCommentID = (*PRReviewComment)(nil)
+
+func mustPRReviewComment(id forge.ChangeCommentID) *PRReviewComment {
+	if id == nil {
+		return nil
+	}
+
+	rc, ok := id.(*PRReviewComment)
+	if !ok {
+		panic(fmt.Sprintf("unexpected PR review comment type: %T", id))
+	}
+	return rc
+}
+
+func (c *PRReviewComment) String() string {
+	return c.URL
+}
+
+func diffSide(side forge.ReviewCommentSide) githubv4.DiffSide {
+	if side == forge.ReviewCommentSideLeft {
+		return githubv4.DiffSideLeft
+	}
+	return githubv4.DiffSideRight
+}
+
+func forgeDiffSide(side githubv4.DiffSide) forge.ReviewCommentSide {
+	if side == githubv4.DiffSideLeft {
+		return forge.ReviewCommentSideLeft
+	}
+	return forge.ReviewCommentSideRight
+}
+
+// PostChangeReviewComment posts a new inline review comment on a PR,
+// anchored to a specific line of the diff.
+//
+// If req.InReplyTo is set, the comment is added as a reply
+// to the thread containing that comment instead.
+func (f *Repository) PostChangeReviewComment(
+	ctx context.Context,
+	id forge.ChangeID,
+	req forge.ReviewCommentRequest,
+) (forge.ChangeCommentID, error) {
+	if req.InReplyTo != nil {
+		return f.replyToReviewComment(ctx, mustPRReviewComment(req.InReplyTo), req.Body)
+	}
+
+	gqlID, err := f.graphQLID(ctx, mustPR(id))
+	if err != nil {
+		return nil, err
+	}
+
+	var m struct {
+		AddPullRequestReviewThread struct {
+			Thread struct {
+				ID       githubv4.ID `graphql:"id"`
+				Comments struct {
+					Nodes []struct {
+						ID  githubv4.ID `graphql:"id"`
+						URL string      `graphql:"url"`
+					} `graphql:"nodes"`
+				} `graphql:"comments(first: 1)"`
+			} `graphql:"thread"`
+		} `graphql:"addPullRequestReviewThread(input: $input)"`
+	}
+
+	side := diffSide(req.Side)
+	input := githubv4.AddPullRequestReviewThreadInput{
+		PullRequestID: &gqlID,
+		Path:          githubv4.String(req.Path),
+		Body:          githubv4.String(req.Body),
+		Line:          githubv4.NewInt(githubv4.Int(req.Line)),
+		Side:          &side,
+	}
+
+	if err := f.client.Mutate(ctx, &m, input, nil); err != nil {
+		return nil, fmt.Errorf("post review comment: %w", err)
+	}
+
+	thread := m.AddPullRequestReviewThread.Thread
+	if len(thread.Comments.Nodes) == 0 {
+		return nil, fmt.Errorf("post review comment: no comment returned for thread %v", thread.ID)
+	}
+	comment := thread.Comments.Nodes[0]
+
+	f.log.Debug("Posted review comment", "url", comment.URL)
+	return &PRReviewComment{
+		GQLID:       comment.ID,
+		ThreadGQLID: thread.ID,
+		URL:         comment.URL,
+	}, nil
+}
+
+func (f *Repository) replyToReviewComment(
+	ctx context.Context,
+	parent *PRReviewComment,
+	body string,
+) (forge.ChangeCommentID, error) {
+	var m struct {
+		AddPullRequestReviewThreadReply struct {
+			Comment struct {
+				ID  githubv4.ID `graphql:"id"`
+				URL string      `graphql:"url"`
+			} `graphql:"comment"`
+		} `graphql:"addPullRequestReviewThreadReply(input: $input)"`
+	}
+
+	input := githubv4.AddPullRequestReviewThreadReplyInput{
+		PullRequestReviewThreadID: parent.ThreadGQLID,
+		Body:                      githubv4.String(body),
+	}
+
+	if err := f.client.Mutate(ctx, &m, input, nil); err != nil {
+		return nil, fmt.Errorf("reply to review comment: %w", err)
+	}
+
+	comment := m.AddPullRequestReviewThreadReply.Comment
+	f.log.Debug("Posted review comment reply", "url", comment.URL)
+	return &PRReviewComment{
+		GQLID:       comment.ID,
+		ThreadGQLID: parent.ThreadGQLID,
+		URL:         comment.URL,
+	}, nil
+}
+
+// UpdateChangeReviewComment updates the contents of
+// an existing review comment on a PR.
+func (f *Repository) UpdateChangeReviewComment(
+	ctx context.Context,
+	id forge.ChangeCommentID,
+	markdown string,
+) error {
+	cid := mustPRReviewComment(id)
+
+	var m struct {
+		UpdatePullRequestReviewComment struct {
+			PullRequestReviewComment struct {
+				ID githubv4.ID `graphql:"id"`
+			} `graphql:"pullRequestReviewComment"`
+		} `graphql:"updatePullRequestReviewComment(input: $input)"`
+	}
+
+	input := githubv4.UpdatePullRequestReviewCommentInput{
+		PullRequestReviewCommentID: cid.GQLID,
+		Body:                       githubv4.String(markdown),
+	}
+	if err := f.client.Mutate(ctx, &m, input, nil); err != nil {
+		return fmt.Errorf("update review comment: %w", err)
+	}
+
+	f.log.Debug("Updated review comment", "url", cid.URL)
+	return nil
+}
+
+// DeleteChangeReviewComment deletes an existing review comment on a PR.
+func (f *Repository) DeleteChangeReviewComment(
+	ctx context.Context,
+	id forge.ChangeCommentID,
+) error {
+	// DeleteChangeReviewComment isn't part of the forge.Repository
+	// interface. It's just nice to have to clean up after the
+	// integration test.
+	cid := mustPRReviewComment(id)
+
+	var m struct {
+		DeletePullRequestReviewComment struct {
+			ClientMutationID githubv4.String `graphql:"clientMutationId"`
+		} `graphql:"deletePullRequestReviewComment(input: $input)"`
+	}
+
+	input := githubv4.DeletePullRequestReviewCommentInput{ID: cid.GQLID}
+	if err := f.client.Mutate(ctx, &m, input, nil); err != nil {
+		return fmt.Errorf("delete review comment: %w", err)
+	}
+
+	return nil
+}
+
+// ListChangeReviewComments lists the review comments left on a PR,
+// oldest first, optionally restricted to a single file.
+func (f *Repository) ListChangeReviewComments(
+	ctx context.Context,
+	id forge.ChangeID,
+	opts *forge.ListChangeReviewCommentsOptions,
+) iter.Seq2[*forge.ReviewComment, error] {
+	gqlID, err := f.graphQLID(ctx, mustPR(id))
+	if err != nil {
+		return func(yield func(*forge.ReviewComment, error) bool) {
+			yield(nil, err)
+		}
+	}
+
+	var path string
+	if opts != nil {
+		path = opts.Path
+	}
+
+	return func(yield func(*forge.ReviewComment, error) bool) {
+		var q struct {
+			Node struct {
+				PullRequest struct {
+					ReviewThreads struct {
+						PageInfo struct {
+							EndCursor   githubv4.String `graphql:"endCursor"`
+							HasNextPage bool            `graphql:"hasNextPage"`
+						} `graphql:"pageInfo"`
+
+						Nodes []struct {
+							ID   githubv4.ID       `graphql:"id"`
+							Path string            `graphql:"path"`
+							Line *int              `graphql:"line"`
+							Side githubv4.DiffSide `graphql:"diffSide"`
+
+							Comments struct {
+								Nodes []struct {
+									ID   githubv4.ID `graphql:"id"`
+									URL  string      `graphql:"url"`
+									Body string      `graphql:"body"`
+								} `graphql:"nodes"`
+							} `graphql:"comments(first: 100)"`
+						} `graphql:"nodes"`
+					} `graphql:"reviewThreads(first: $first, after: $after)"`
+				} `graphql:"... on PullRequest"`
+			} `graphql:"node(id: $id)"`
+		}
+
+		variables := map[string]any{
+			"id":    gqlID,
+			"first": githubv4.Int(_listChangeCommentsPageSize),
+			"after": (*githubv4.String)(nil),
+		}
+
+		for pageNum := 1; true; pageNum++ {
+			if err := f.client.Query(ctx, &q, variables); err != nil {
+				yield(nil, fmt.Errorf("list review comments (page %d): %w", pageNum, err))
+				return
+			}
+
+			for _, thread := range q.Node.PullRequest.ReviewThreads.Nodes {
+				if path != "" && thread.Path != path {
+					continue
+				}
+
+				var line int
+				if thread.Line != nil {
+					line = *thread.Line
+				}
+
+				var prevID *PRReviewComment
+				for _, node := range thread.Comments.Nodes {
+					comment := &forge.ReviewComment{
+						ID: &PRReviewComment{
+							GQLID:       node.ID,
+							ThreadGQLID: thread.ID,
+							URL:         node.URL,
+						},
+						Path: thread.Path,
+						Line: line,
+						Side: forgeDiffSide(thread.Side),
+						Body: node.Body,
+					}
+					if prevID != nil {
+						comment.InReplyTo = prevID
+					}
+					prevID = mustPRReviewComment(comment.ID)
+
+					if !yield(comment, nil) {
+						return
+					}
+				}
+			}
+
+			if !q.Node.PullRequest.ReviewThreads.PageInfo.HasNextPage {
+				return
+			}
+
+			variables["after"] = q.Node.PullRequest.ReviewThreads.PageInfo.EndCursor
+		}
+	}
+}
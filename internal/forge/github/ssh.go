@@ -0,0 +1,103 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/google/go-github/v61/github"
+	"go.abhg.dev/gs/internal/sshkey"
+	"go.abhg.dev/gs/internal/text"
+	"go.abhg.dev/gs/internal/ui"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/oauth2"
+)
+
+// offerSSHKeySetup asks the user, if they're in an interactive session,
+// whether they'd like to generate an SSH key and add it to their GitHub
+// account, and does so if they agree.
+//
+// Failures here are logged but do not fail the overall login flow --
+// SSH setup is a convenience, not a requirement for authentication.
+func (f *Forge) offerSSHKeySetup(ctx context.Context, view ui.View, tok *AuthenticationToken) {
+	if !ui.Interactive(view) {
+		return
+	}
+
+	log := f.logger()
+
+	setup := false
+	prompt := ui.NewConfirm().
+		WithTitle("Set up SSH access to GitHub?").
+		WithDescription(text.Dedent(`
+			git-spice can generate an ed25519 SSH key and add it to your
+			GitHub account so that 'git' operations over SSH work without
+			further setup.
+		`)).
+		WithValue(&setup)
+	if err := ui.Run(view, prompt); err != nil {
+		log.Warnf("Could not prompt for SSH setup: %v", err)
+		return
+	}
+	if !setup {
+		return
+	}
+
+	if err := f.setupSSHKey(ctx, view, tok); err != nil {
+		log.Warnf("Could not set up SSH access: %v", err)
+	}
+}
+
+// setupSSHKey ensures the user has a local ed25519 SSH key,
+// and that it's registered with their GitHub account.
+func (f *Forge) setupSSHKey(ctx context.Context, view ui.View, tok *AuthenticationToken) error {
+	keyPath, pub, generated, err := sshkey.Ensure()
+	if err != nil {
+		return fmt.Errorf("prepare SSH key: %w", err)
+	}
+
+	httpClient := oauth2.NewClient(ctx, tok.tokenSource())
+	client := github.NewClient(httpClient)
+	if apiURL := f.APIURL(); apiURL != DefaultAPIURL {
+		client, err = client.WithEnterpriseURLs(apiURL, apiURL)
+		if err != nil {
+			return fmt.Errorf("configure GitHub Enterprise client: %w", err)
+		}
+	}
+
+	keys, _, err := client.Users.ListKeys(ctx, "", nil)
+	if err != nil {
+		return fmt.Errorf("list SSH keys: %w", err)
+	}
+
+	fp := ssh.FingerprintSHA256(pub)
+	for _, k := range keys {
+		existingFP, err := sshkey.Fingerprint(k.GetKey())
+		if err != nil {
+			continue // not a key we understand; ignore it
+		}
+		if existingFP == fp {
+			fmt.Fprintf(view, "SSH key %v is already registered with your GitHub account.\n", keyPath)
+			return nil
+		}
+	}
+
+	title := "git-spice"
+	if host, err := os.Hostname(); err == nil {
+		title = fmt.Sprintf("git-spice (%s)", host)
+	}
+	authorizedKey := string(ssh.MarshalAuthorizedKey(pub))
+	if _, _, err := client.Users.CreateKey(ctx, &github.Key{
+		Title: &title,
+		Key:   &authorizedKey,
+	}); err != nil {
+		return fmt.Errorf("upload SSH key: %w", err)
+	}
+
+	if generated {
+		fmt.Fprintf(view, "Generated a new SSH key at %v and added it to your GitHub account.\n", keyPath)
+	} else {
+		fmt.Fprintf(view, "Added %v to your GitHub account.\n", keyPath)
+	}
+	return nil
+}
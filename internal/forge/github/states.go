@@ -6,14 +6,22 @@ import (
 
 	"github.com/shurcooL/githubv4"
 	"go.abhg.dev/gs/internal/forge"
+	"go.abhg.dev/gs/internal/git"
 )
 
-// ChangesStates retrieves the states of the given changes in bulk.
-func (r *Repository) ChangesStates(ctx context.Context, ids []forge.ChangeID) ([]forge.ChangeState, error) {
+// ChangeStatuses retrieves the status of the given changes in bulk.
+func (r *Repository) ChangeStatuses(ctx context.Context, ids []forge.ChangeID) ([]*forge.ChangeStatus, error) {
 	var q struct {
-		Nodes []struct {
+		Nodes []*struct {
 			PullRequest struct {
-				State githubv4.PullRequestState `graphql:"state"`
+				State       githubv4.PullRequestState `graphql:"state"`
+				IsDraft     githubv4.Boolean          `graphql:"isDraft"`
+				HeadRefOid  githubv4.GitObjectID      `graphql:"headRefOid"`
+				BaseRefName githubv4.String           `graphql:"baseRefName"`
+				MergedAt    githubv4.DateTime         `graphql:"mergedAt"`
+				MergeCommit *struct {
+					Oid githubv4.GitObjectID `graphql:"oid"`
+				} `graphql:"mergeCommit"`
 			} `graphql:"... on PullRequest"`
 		} `graphql:"nodes(ids: $ids)"`
 	}
@@ -29,13 +37,30 @@ func (r *Repository) ChangesStates(ctx context.Context, ids []forge.ChangeID) ([
 	}
 
 	if err := r.client.Query(ctx, &q, map[string]any{"ids": gqlIDs}); err != nil {
-		return nil, fmt.Errorf("retrieve change states: %w", err)
+		return nil, fmt.Errorf("retrieve change statuses: %w", err)
 	}
 
-	states := make([]forge.ChangeState, len(ids))
+	statuses := make([]*forge.ChangeStatus, len(ids))
 	for i, pr := range q.Nodes {
-		states[i] = forgeChangeState(pr.PullRequest.State)
+		if pr == nil {
+			// GitHub returns a null node for an ID it can't
+			// resolve, e.g. a PR that was deleted.
+			statuses[i] = &forge.ChangeStatus{State: forge.ChangeNotFound}
+			continue
+		}
+
+		status := &forge.ChangeStatus{
+			State:   forgeChangeState(pr.PullRequest.State),
+			HeadSHA: git.Hash(pr.PullRequest.HeadRefOid),
+			BaseRef: string(pr.PullRequest.BaseRefName),
+			IsDraft: bool(pr.PullRequest.IsDraft),
+		}
+		if pr.PullRequest.MergeCommit != nil {
+			status.MergeCommit = git.Hash(pr.PullRequest.MergeCommit.Oid)
+			status.MergedAt = pr.PullRequest.MergedAt.Time
+		}
+		statuses[i] = status
 	}
 
-	return states, nil
+	return statuses, nil
 }
@@ -1,6 +1,7 @@
 package github
 
 import (
+	"errors"
 	"fmt"
 	"os/exec"
 	"strings"
@@ -27,7 +28,11 @@ func (ts *CLITokenSource) Token() (*oauth2.Token, error) {
 	if err != nil {
 		return nil, fmt.Errorf("get token from gh CLI: %w", err)
 	}
-	return &oauth2.Token{
-		AccessToken: strings.TrimSpace(string(bs)),
-	}, nil
+
+	tok := strings.TrimSpace(string(bs))
+	if tok == "" {
+		return nil, errors.New("token not found in gh output")
+	}
+
+	return &oauth2.Token{AccessToken: tok}, nil
 }
@@ -30,4 +30,16 @@ func TestCLITokenSource(t *testing.T) {
 		require.Error(t, err)
 		assert.ErrorIs(t, err, assert.AnError)
 	})
+
+	t.Run("empty", func(t *testing.T) {
+		ts := &CLITokenSource{
+			cmdOutput: func(*exec.Cmd) ([]byte, error) {
+				return []byte("\n"), nil
+			},
+		}
+
+		_, err := ts.Token()
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "token not found")
+	})
 }
@@ -158,7 +158,14 @@ func (f *Forge) AuthenticationFlow(ctx context.Context, view ui.View) (forge.Aut
 		return nil, fmt.Errorf("select authenticator: %w", err)
 	}
 
-	return auth.Authenticate(ctx, view)
+	tok, err := auth.Authenticate(ctx, view)
+	if err != nil {
+		return nil, err
+	}
+
+	f.offerSSHKeySetup(ctx, view, tok)
+
+	return tok, nil
 }
 
 // SaveAuthenticationToken saves the given authentication token to the stash.
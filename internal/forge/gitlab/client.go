@@ -3,17 +3,22 @@ package gitlab
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"go.abhg.dev/gs/internal/correlation"
 	"go.abhg.dev/gs/internal/must"
 	"golang.org/x/oauth2"
 )
 
 type gitlabClient struct {
+	Commits          commitsService
+	Discussions      discussionsService
 	MergeRequests    mergeRequestsService
 	Notes            notesService
 	Projects         projectsService
 	ProjectTemplates projectTemplatesService
+	RepositoryFiles  repositoryFilesService
 	Users            usersService
 }
 
@@ -46,15 +51,25 @@ func newGitLabClient(ctx context.Context, baseURL string, tok *AuthenticationTok
 	must.NotBeNilf(authSource,
 		"No source for authentication type: %v", tok.AuthType)
 
-	client, err := gitlab.NewAuthSourceClient(authSource, gitlab.WithBaseURL(baseURL))
+	// Tag every outbound request with the invocation's correlation ID,
+	// so that server-side logs can be matched up with a user's bug report.
+	httpClient := &http.Client{Transport: correlation.Transport(nil)}
+
+	client, err := gitlab.NewAuthSourceClient(authSource,
+		gitlab.WithBaseURL(baseURL),
+		gitlab.WithHTTPClient(httpClient),
+	)
 	if err != nil {
 		return nil, err
 	}
 	return &gitlabClient{
+		Commits:          client.Commits,
+		Discussions:      client.Discussions,
 		MergeRequests:    client.MergeRequests,
 		Notes:            client.Notes,
 		ProjectTemplates: client.ProjectTemplates,
 		Projects:         client.Projects,
+		RepositoryFiles:  client.RepositoryFiles,
 		Users:            client.Users,
 	}, nil
 }
@@ -98,6 +113,59 @@ type mergeRequestsService interface {
 	) ([]*gitlab.BasicMergeRequest, *gitlab.Response, error)
 }
 
+// commitsService allows fetching commit metadata, including
+// signature verification status.
+type commitsService interface {
+	GetGPGSignature(
+		pid any,
+		sha string,
+		options ...gitlab.RequestOptionFunc,
+	) (*gitlab.GPGSignature, *gitlab.Response, error)
+}
+
+// discussionsService allows posting, listing, and fetching discussions
+// (diff-anchored comment threads) on merge requests.
+type discussionsService interface {
+	CreateMergeRequestDiscussion(
+		pid any,
+		mergeRequest int64,
+		opt *gitlab.CreateMergeRequestDiscussionOptions,
+		options ...gitlab.RequestOptionFunc,
+	) (*gitlab.Discussion, *gitlab.Response, error)
+
+	AddMergeRequestDiscussionNote(
+		pid any,
+		mergeRequest int64,
+		discussion string,
+		opt *gitlab.AddMergeRequestDiscussionNoteOptions,
+		options ...gitlab.RequestOptionFunc,
+	) (*gitlab.Note, *gitlab.Response, error)
+
+	UpdateMergeRequestDiscussionNote(
+		pid any,
+		mergeRequest int64,
+		discussion string,
+		note int64,
+		opt *gitlab.UpdateMergeRequestDiscussionNoteOptions,
+		options ...gitlab.RequestOptionFunc,
+	) (*gitlab.Note, *gitlab.Response, error)
+
+	DeleteMergeRequestDiscussionNote(
+		pid any,
+		mergeRequest int64,
+		discussion string,
+		note int64,
+		options ...gitlab.RequestOptionFunc,
+	) (*gitlab.Response, error)
+
+	ListMergeRequestDiscussions(
+		pid any,
+		mergeRequest int64,
+		opt *gitlab.ListMergeRequestDiscussionsOptions,
+		options ...gitlab.RequestOptionFunc,
+	) ([]*gitlab.Discussion, *gitlab.Response, error)
+}
+
 // notesService allows posting, listing, and fetching notes (comments)
 // on merge requests.
 type notesService interface {
@@ -156,6 +224,17 @@ type projectTemplatesService interface {
 	) (*gitlab.ProjectTemplate, *gitlab.Response, error)
 }
 
+// repositoryFilesService allows reading individual file contents
+// from a repository.
+type repositoryFilesService interface {
+	GetFile(
+		pid any,
+		fileName string,
+		opt *gitlab.GetFileOptions,
+		options ...gitlab.RequestOptionFunc,
+	) (*gitlab.File, *gitlab.Response, error)
+}
+
 // usersService allows listing and accessing users.
 type usersService interface {
 	CurrentUser(
@@ -166,4 +245,13 @@ type usersService interface {
 		opt *gitlab.ListUsersOptions,
 		options ...gitlab.RequestOptionFunc,
 	) ([]*gitlab.User, *gitlab.Response, error)
+
+	ListSSHKeys(
+		options ...gitlab.RequestOptionFunc,
+	) ([]*gitlab.SSHKey, *gitlab.Response, error)
+
+	AddSSHKey(
+		opt *gitlab.AddSSHKeyOptions,
+		options ...gitlab.RequestOptionFunc,
+	) (*gitlab.SSHKey, *gitlab.Response, error)
 }
@@ -0,0 +1,26 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ReadFile reads the contents of a file at the given Git ref.
+func (r *Repository) ReadFile(ctx context.Context, ref, path string) ([]byte, error) {
+	f, _, err := r.client.RepositoryFiles.GetFile(
+		r.repoID, path, &gitlab.GetFileOptions{Ref: &ref}, gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("read %q at %q: %w", path, ref, err)
+	}
+
+	content, err := base64.StdEncoding.DecodeString(f.Content)
+	if err != nil {
+		return nil, fmt.Errorf("read %q at %q: decode content: %w", path, ref, err)
+	}
+
+	return content, nil
+}
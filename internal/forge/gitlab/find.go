@@ -9,7 +9,7 @@ import (
 	"go.abhg.dev/gs/internal/git"
 )
 
-func toFindChangeItem(mr *gitlab.MergeRequest) *forge.FindChangeItem {
+func toFindChangeItem(mr *gitlab.MergeRequest, verified bool) *forge.FindChangeItem {
 	return &forge.FindChangeItem{
 		ID: &MR{
 			Number: mr.IID,
@@ -20,9 +20,21 @@ func toFindChangeItem(mr *gitlab.MergeRequest) *forge.FindChangeItem {
 		BaseName: mr.TargetBranch,
 		HeadHash: git.Hash(mr.SHA),
 		Draft:    mr.Draft,
+		Verified: verified,
 	}
 }
 
+// commitVerified reports whether the commit at sha has a verified GPG
+// signature. Commits with no signature are reported as unverified,
+// not as an error.
+func (r *Repository) commitVerified(ctx context.Context, sha string) bool {
+	sig, _, err := r.client.Commits.GetGPGSignature(r.repoID, sha, gitlab.WithContext(ctx))
+	if err != nil {
+		return false
+	}
+	return sig.VerificationStatus == "verified"
+}
+
 func mergeRequestState(s forge.ChangeState) string {
 	switch s {
 	case forge.ChangeOpen:
@@ -78,7 +90,7 @@ func (r *Repository) FindChangesByBranch(ctx context.Context, branch string, opt
 
 	changes := make([]*forge.FindChangeItem, len(requests))
 	for i, mr := range requests {
-		changes[i] = toFindChangeItem(mr)
+		changes[i] = toFindChangeItem(mr, r.commitVerified(ctx, mr.SHA))
 	}
 
 	return changes, nil
@@ -94,5 +106,5 @@ func (r *Repository) FindChangeByID(ctx context.Context, id forge.ChangeID) (*fo
 		return nil, fmt.Errorf("find change by ID: %w", err)
 	}
 
-	return toFindChangeItem(mr), nil
+	return toFindChangeItem(mr, r.commitVerified(ctx, mr.SHA)), nil
 }
@@ -56,10 +56,13 @@ func newGitLabClient(
 	}
 	client, _ := gogitlab.NewClient(token, gogitlab.WithHTTPClient(httpClient))
 	return &gitlab.Client{
+		Commits:          client.Commits,
+		Discussions:      client.Discussions,
 		MergeRequests:    client.MergeRequests,
 		Notes:            client.Notes,
 		ProjectTemplates: client.ProjectTemplates,
 		Projects:         client.Projects,
+		RepositoryFiles:  client.RepositoryFiles,
 		Users:            client.Users,
 	}
 }
@@ -133,10 +136,15 @@ func TestIntegration(t *testing.T) {
 		CloseChange: func(t *testing.T, repo forge.Repository, change forge.ChangeID) {
 			require.NoError(t, gitlab.CloseChange(t.Context(), repo.(*gitlab.Repository), change.(*gitlab.MR)))
 		},
+		SubmitReview: func(t *testing.T, repo forge.Repository, change forge.ChangeID, req forge.SubmitReviewRequest) {
+			require.NoError(t, repo.SubmitReview(t.Context(), change, req))
+		},
 		SetCommentsPageSize:   gitlab.SetListChangeCommentsPageSize,
 		BaseBranchMayBeAbsent: true,
 		Reviewers:             []string{"abg"},
 		Assignees:             []string{"abg"},
+		SupportsLFS:           true,
+		InternalRefPattern:    "refs/merge-requests/{id}/head",
 	})
 }
 
@@ -0,0 +1,129 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"go.abhg.dev/gs/internal/forge"
+)
+
+// SubmitReview leaves a review on a merge request
+// on behalf of the authenticated user.
+//
+// GitLab has no native concept of a "changes requested" or "commented"
+// review the way GitHub does, so only the approval itself is recorded
+// as a first-class action; any body is left as a regular note.
+func (r *Repository) SubmitReview(
+	ctx context.Context,
+	id forge.ChangeID,
+	req forge.SubmitReviewRequest,
+) error {
+	mr := mustMR(id)
+
+	switch req.State {
+	case forge.ReviewApproved:
+		_, _, err := r.client.MergeRequestApprovals.ApproveMergeRequest(
+			r.repoID, int(mr.Number), nil, gitlab.WithContext(ctx),
+		)
+		if err != nil {
+			return fmt.Errorf("approve merge request: %w", err)
+		}
+
+	case forge.ReviewChangesRequested, forge.ReviewCommented:
+		// No native equivalent: leave the review body as a note.
+
+	default:
+		return fmt.Errorf("cannot submit a review with state %v", req.State)
+	}
+
+	if req.Body != "" {
+		noteOptions := gitlab.CreateMergeRequestNoteOptions{Body: &req.Body}
+		_, _, err := r.client.Notes.CreateMergeRequestNote(
+			r.repoID, mr.Number, &noteOptions, gitlab.WithContext(ctx),
+		)
+		if err != nil {
+			return fmt.Errorf("post review note: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ListChangeReviews lists the reviews left on a merge request, oldest first.
+//
+// Approvals are reported as [forge.ReviewApproved] reviews.
+// All other review activity is inferred from non-system notes
+// and reported as [forge.ReviewCommented].
+func (r *Repository) ListChangeReviews(
+	ctx context.Context,
+	id forge.ChangeID,
+) iter.Seq2[*forge.Review, error] {
+	mr := mustMR(id)
+
+	return func(yield func(*forge.Review, error) bool) {
+		approvals, _, err := r.client.MergeRequestApprovals.GetConfiguration(
+			r.repoID, int(mr.Number), gitlab.WithContext(ctx),
+		)
+		if err != nil {
+			yield(nil, fmt.Errorf("get approvals: %w", err))
+			return
+		}
+
+		for _, approver := range approvals.ApprovedBy {
+			review := &forge.Review{
+				Reviewer: approver.User.Username,
+				State:    forge.ReviewApproved,
+			}
+			if !yield(review, nil) {
+				return
+			}
+		}
+
+		notesOptions := gitlab.ListMergeRequestNotesOptions{
+			Sort: gitlab.Ptr("asc"),
+			ListOptions: gitlab.ListOptions{
+				PerPage: int64(_listChangeCommentsPageSize),
+			},
+		}
+
+		for pageNum := 1; true; pageNum++ {
+			notes, response, err := r.client.Notes.ListMergeRequestNotes(
+				r.repoID, mr.Number, &notesOptions, gitlab.WithContext(ctx),
+			)
+			if err != nil {
+				yield(nil, fmt.Errorf("list notes (page %d): %w", pageNum, err))
+				return
+			}
+
+			for _, note := range notes {
+				if note.System {
+					continue
+				}
+
+				var submittedAt time.Time
+				if note.CreatedAt != nil {
+					submittedAt = *note.CreatedAt
+				}
+
+				review := &forge.Review{
+					Reviewer:    note.Author.Username,
+					State:       forge.ReviewCommented,
+					Body:        note.Body,
+					SubmittedAt: submittedAt,
+				}
+				if !yield(review, nil) {
+					return
+				}
+			}
+
+			if response.CurrentPage >= response.TotalPages {
+				return
+			}
+
+			notesOptions.Page = response.NextPage
+		}
+	}
+}
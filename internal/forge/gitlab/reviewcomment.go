@@ -0,0 +1,247 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strconv"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"go.abhg.dev/gs/internal/forge"
+)
+
+// MRReviewComment identifies a single note inside a diff discussion
+// on a GitLab MR.
+//
+// MRReviewComment implements [forge.ChangeCommentID].
+type MRReviewComment struct {
+	// Number is the ID of the note.
+	Number int64 `json:"number"` // required
+
+	// MRNumber is the ID of the MR the note is on.
+	MRNumber int64 `json:"mr_number"` // required
+
+	// DiscussionID is the ID of the discussion (thread)
+	// the note belongs to.
+	DiscussionID string `json:"discussion_id"` // required
+}
+
+var _ forge.ChangeCommentID = (*MRReviewComment)(nil)
+
+func mustMRReviewComment(id forge.ChangeCommentID) *MRReviewComment {
+	if id == nil {
+		return nil
+	}
+
+	rc, ok := id.(*MRReviewComment)
+	if !ok {
+		panic(fmt.Sprintf("unexpected MR review comment type: %T", id))
+	}
+	return rc
+}
+
+func (c *MRReviewComment) String() string {
+	return strconv.FormatInt(c.Number, 10)
+}
+
+// PostChangeReviewComment posts a new inline review comment on an MR,
+// anchored to a specific line of the diff.
+//
+// If req.InReplyTo is set, the comment is added as a note
+// to the discussion containing that comment instead.
+func (r *Repository) PostChangeReviewComment(
+	ctx context.Context,
+	id forge.ChangeID,
+	req forge.ReviewCommentRequest,
+) (forge.ChangeCommentID, error) {
+	mrNumber := mustMR(id).Number
+
+	if req.InReplyTo != nil {
+		parent := mustMRReviewComment(req.InReplyTo)
+		note, _, err := r.client.Discussions.AddMergeRequestDiscussionNote(
+			r.repoID, mrNumber, parent.DiscussionID,
+			&gitlab.AddMergeRequestDiscussionNoteOptions{Body: &req.Body},
+			gitlab.WithContext(ctx),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("reply to review comment: %w", err)
+		}
+
+		r.log.Debug("Posted review comment reply", "id", note.ID, "mr", mrNumber)
+		return &MRReviewComment{
+			Number:       note.ID,
+			MRNumber:     mrNumber,
+			DiscussionID: parent.DiscussionID,
+		}, nil
+	}
+
+	mergeRequest, _, err := r.client.MergeRequests.GetMergeRequest(
+		r.repoID, mrNumber, nil, gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get merge request for review comment: %w", err)
+	}
+
+	position := &gitlab.PositionOptions{
+		BaseSHA:      &mergeRequest.DiffRefs.BaseSha,
+		HeadSHA:      &mergeRequest.DiffRefs.HeadSha,
+		StartSHA:     &mergeRequest.DiffRefs.StartSha,
+		NewPath:      &req.Path,
+		OldPath:      &req.Path,
+		PositionType: gitlab.Ptr("text"),
+	}
+	if req.Side == forge.ReviewCommentSideLeft {
+		position.OldLine = &req.Line
+	} else {
+		position.NewLine = &req.Line
+	}
+
+	discussion, _, err := r.client.Discussions.CreateMergeRequestDiscussion(
+		r.repoID, mrNumber,
+		&gitlab.CreateMergeRequestDiscussionOptions{
+			Body:     &req.Body,
+			Position: position,
+		},
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("post review comment: %w", err)
+	}
+	if len(discussion.Notes) == 0 {
+		return nil, fmt.Errorf("post review comment: no note returned for discussion %v", discussion.ID)
+	}
+	note := discussion.Notes[0]
+
+	r.log.Debug("Posted review comment", "id", note.ID, "mr", mrNumber)
+	return &MRReviewComment{
+		Number:       note.ID,
+		MRNumber:     mrNumber,
+		DiscussionID: discussion.ID,
+	}, nil
+}
+
+// UpdateChangeReviewComment updates the contents of
+// an existing review comment on an MR.
+func (r *Repository) UpdateChangeReviewComment(
+	ctx context.Context,
+	id forge.ChangeCommentID,
+	markdown string,
+) error {
+	rc := mustMRReviewComment(id)
+
+	_, _, err := r.client.Discussions.UpdateMergeRequestDiscussionNote(
+		r.repoID, rc.MRNumber, rc.DiscussionID, rc.Number,
+		&gitlab.UpdateMergeRequestDiscussionNoteOptions{Body: &markdown},
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("update review comment: %w", err)
+	}
+
+	r.log.Debug("Updated review comment", "id", rc.Number, "mr", rc.MRNumber)
+	return nil
+}
+
+// DeleteChangeReviewComment deletes an existing review comment on an MR.
+func (r *Repository) DeleteChangeReviewComment(
+	ctx context.Context,
+	id forge.ChangeCommentID,
+) error {
+	// DeleteChangeReviewComment isn't part of the forge.Repository
+	// interface. It's just nice to have to clean up after the
+	// integration test.
+	rc := mustMRReviewComment(id)
+
+	_, err := r.client.Discussions.DeleteMergeRequestDiscussionNote(
+		r.repoID, rc.MRNumber, rc.DiscussionID, rc.Number,
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("delete review comment: %w", err)
+	}
+
+	r.log.Debug("Deleted review comment", "id", rc.Number, "mr", rc.MRNumber)
+	return nil
+}
+
+// ListChangeReviewComments lists the review comments left on an MR,
+// oldest first, optionally restricted to a single file.
+//
+// GitLab's API does not paginate discussions by note, only by
+// discussion, so filtering by path happens client-side.
+func (r *Repository) ListChangeReviewComments(
+	ctx context.Context,
+	id forge.ChangeID,
+	opts *forge.ListChangeReviewCommentsOptions,
+) iter.Seq2[*forge.ReviewComment, error] {
+	mrNumber := mustMR(id).Number
+
+	var path string
+	if opts != nil {
+		path = opts.Path
+	}
+
+	return func(yield func(*forge.ReviewComment, error) bool) {
+		discussionsOptions := gitlab.ListMergeRequestDiscussionsOptions{
+			PerPage: int64(_listChangeCommentsPageSize),
+		}
+
+		for pageNum := 1; true; pageNum++ {
+			discussions, response, err := r.client.Discussions.ListMergeRequestDiscussions(
+				r.repoID, mrNumber, &discussionsOptions,
+				gitlab.WithContext(ctx),
+			)
+			if err != nil {
+				yield(nil, fmt.Errorf("list review comments (page %d): %w", pageNum, err))
+				return
+			}
+
+			for _, discussion := range discussions {
+				if len(discussion.Notes) == 0 || discussion.Notes[0].Position == nil {
+					continue // not a diff-anchored discussion
+				}
+
+				pos := discussion.Notes[0].Position
+				if path != "" && pos.NewPath != path {
+					continue
+				}
+
+				line := pos.NewLine
+				side := forge.ReviewCommentSideRight
+				if pos.NewLine == 0 {
+					line = pos.OldLine
+					side = forge.ReviewCommentSideLeft
+				}
+
+				var prevID *MRReviewComment
+				for _, note := range discussion.Notes {
+					comment := &forge.ReviewComment{
+						ID: &MRReviewComment{
+							Number:       note.ID,
+							MRNumber:     mrNumber,
+							DiscussionID: discussion.ID,
+						},
+						Path: pos.NewPath,
+						Line: line,
+						Side: side,
+						Body: note.Body,
+					}
+					if prevID != nil {
+						comment.InReplyTo = prevID
+					}
+					prevID = mustMRReviewComment(comment.ID)
+
+					if !yield(comment, nil) {
+						return
+					}
+				}
+			}
+
+			if response.CurrentPage >= response.TotalPages {
+				return
+			}
+
+			discussionsOptions.Page = response.NextPage
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"go.abhg.dev/gs/internal/sshkey"
+	"go.abhg.dev/gs/internal/text"
+	"go.abhg.dev/gs/internal/ui"
+	"golang.org/x/crypto/ssh"
+)
+
+// offerSSHKeySetup asks the user, if they're in an interactive session,
+// whether they'd like to generate an SSH key and add it to their GitLab
+// account, and does so if they agree.
+//
+// Failures here are logged but do not fail the overall login flow --
+// SSH setup is a convenience, not a requirement for authentication.
+func (f *Forge) offerSSHKeySetup(ctx context.Context, view ui.View, tok *AuthenticationToken) {
+	if !ui.Interactive(view) {
+		return
+	}
+
+	log := f.logger()
+
+	setup := false
+	prompt := ui.NewConfirm().
+		WithTitle("Set up SSH access to GitLab?").
+		WithDescription(text.Dedent(`
+			git-spice can generate an ed25519 SSH key and add it to your
+			GitLab account so that 'git' operations over SSH work without
+			further setup.
+		`)).
+		WithValue(&setup)
+	if err := ui.Run(view, prompt); err != nil {
+		log.Warnf("Could not prompt for SSH setup: %v", err)
+		return
+	}
+	if !setup {
+		return
+	}
+
+	if err := f.setupSSHKey(ctx, view, tok); err != nil {
+		log.Warnf("Could not set up SSH access: %v", err)
+	}
+}
+
+// setupSSHKey ensures the user has a local ed25519 SSH key,
+// and that it's registered with their GitLab account.
+func (f *Forge) setupSSHKey(ctx context.Context, view ui.View, tok *AuthenticationToken) error {
+	keyPath, pub, generated, err := sshkey.Ensure()
+	if err != nil {
+		return fmt.Errorf("prepare SSH key: %w", err)
+	}
+
+	client, err := newGitLabClient(ctx, f.APIURL(), tok)
+	if err != nil {
+		return fmt.Errorf("create GitLab client: %w", err)
+	}
+
+	keys, _, err := client.Users.ListSSHKeys()
+	if err != nil {
+		return fmt.Errorf("list SSH keys: %w", err)
+	}
+
+	fp := ssh.FingerprintSHA256(pub)
+	for _, k := range keys {
+		existingFP, err := sshkey.Fingerprint(k.Key)
+		if err != nil {
+			continue // not a key we understand; ignore it
+		}
+		if existingFP == fp {
+			fmt.Fprintf(view, "SSH key %v is already registered with your GitLab account.\n", keyPath)
+			return nil
+		}
+	}
+
+	title := "git-spice"
+	if host, err := os.Hostname(); err == nil {
+		title = fmt.Sprintf("git-spice (%s)", host)
+	}
+	authorizedKey := string(ssh.MarshalAuthorizedKey(pub))
+	if _, _, err := client.Users.AddSSHKey(&gitlab.AddSSHKeyOptions{
+		Title: &title,
+		Key:   &authorizedKey,
+	}); err != nil {
+		return fmt.Errorf("upload SSH key: %w", err)
+	}
+
+	if generated {
+		fmt.Fprintf(view, "Generated a new SSH key at %v and added it to your GitLab account.\n", keyPath)
+	} else {
+		fmt.Fprintf(view, "Added %v to your GitLab account.\n", keyPath)
+	}
+	return nil
+}
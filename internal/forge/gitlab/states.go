@@ -6,10 +6,11 @@ import (
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 	"go.abhg.dev/gs/internal/forge"
+	"go.abhg.dev/gs/internal/git"
 )
 
-// ChangesStates retrieves the states of the given changes in bulk.
-func (r *Repository) ChangesStates(ctx context.Context, ids []forge.ChangeID) ([]forge.ChangeState, error) {
+// ChangeStatuses retrieves the status of the given changes in bulk.
+func (r *Repository) ChangeStatuses(ctx context.Context, ids []forge.ChangeID) ([]*forge.ChangeStatus, error) {
 	mrIDs := make([]int64, len(ids))
 	for i, id := range ids {
 		mrIDs[i] = mustMR(id).Number
@@ -29,20 +30,40 @@ func (r *Repository) ChangesStates(ctx context.Context, ids []forge.ChangeID) ([
 		mrMap[mr.IID] = mr
 	}
 
-	states := make([]forge.ChangeState, len(mrIDs))
+	statuses := make([]*forge.ChangeStatus, len(mrIDs))
 	for i, id := range mrIDs {
-		mr := mrMap[id]
+		mr, ok := mrMap[id]
+		if !ok {
+			// The merge request wasn't returned by the list query,
+			// e.g. because it was deleted, or it belongs to a
+			// different project than the one we queried.
+			statuses[i] = &forge.ChangeStatus{State: forge.ChangeNotFound}
+			continue
+		}
+
+		status := &forge.ChangeStatus{
+			HeadSHA: git.Hash(mr.SHA),
+			BaseRef: mr.TargetBranch,
+			IsDraft: mr.Draft,
+		}
 		switch mr.State {
 		case "opened":
-			states[i] = forge.ChangeOpen
+			status.State = forge.ChangeOpen
 		case "merged":
-			states[i] = forge.ChangeMerged
+			status.State = forge.ChangeMerged
 		case "closed":
-			states[i] = forge.ChangeClosed
+			status.State = forge.ChangeClosed
 		default:
-			states[i] = forge.ChangeOpen // default to open for unknown states
+			status.State = forge.ChangeOpen // default to open for unknown states
+		}
+		if mr.MergedAt != nil {
+			status.MergedAt = *mr.MergedAt
+		}
+		if mr.MergeCommitSHA != "" {
+			status.MergeCommit = git.Hash(mr.MergeCommitSHA)
 		}
+		statuses[i] = status
 	}
 
-	return states, nil
+	return statuses, nil
 }
@@ -5,6 +5,7 @@ import (
 	"os/exec"
 	"slices"
 	"strings"
+	"time"
 
 	"go.abhg.dev/gs/internal/forge"
 	"go.abhg.dev/gs/internal/silog"
@@ -101,6 +102,14 @@ type shamChange struct {
 
 	// Assignees are users assigned to the change.
 	Assignees []string
+
+	// MergedAt is the time at which the change was merged.
+	// It's the zero value if the change has not been merged.
+	MergedAt time.Time
+
+	// MergeCommitHash is the hash of the commit that the change was
+	// merged as. It's empty if the change has not been merged.
+	MergeCommitHash string
 }
 
 // Change is a change proposal against a repository.
@@ -148,6 +157,10 @@ type Change struct {
 
 	// Assignees are users assigned to the change.
 	Assignees []string `json:"assignees,omitempty"`
+
+	// Verified indicates that the commit at the top of Head
+	// has a verified cryptographic signature.
+	Verified bool `json:"verified,omitempty"`
 }
 
 // toChange converts an internal shamChange
@@ -181,6 +194,7 @@ func (sh *ShamHub) toChange(c shamChange) (*Change, error) {
 		Labels:             c.Labels,
 		RequestedReviewers: requestedReviewers,
 		Assignees:          assignees,
+		Verified:           sh.commitVerified(c.Head.Owner, c.Head.Repo, head.Hash),
 	}
 	switch c.State {
 	case shamChangeOpen:
@@ -227,3 +241,30 @@ func (sh *ShamHub) toChangeBranch(b *shamBranch) (*ChangeBranch, error) {
 		Hash: strings.TrimSpace(string(out)),
 	}, nil
 }
+
+// commitVerified reports whether the commit at hash has a good,
+// verifiable cryptographic signature.
+//
+// Good signatures count as verified even when the validity
+// of the signing key is unknown (git's "U" status).
+// Unsigned commits, and signatures that are bad or cannot be checked,
+// are reported as unverified rather than as an error.
+func (sh *ShamHub) commitVerified(owner, repo, hash string) bool {
+	logw, flush := silog.Writer(sh.log, silog.LevelDebug)
+	defer flush()
+
+	cmd := exec.Command(sh.gitExe, "log", "-1", "--format=%G?", hash)
+	cmd.Dir = sh.repoDir(owner, repo)
+	cmd.Stderr = logw
+	out, err := cmd.Output()
+	if err != nil {
+		return false
+	}
+
+	switch strings.TrimSpace(string(out)) {
+	case "G", "U":
+		return true
+	default:
+		return false
+	}
+}
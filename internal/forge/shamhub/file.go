@@ -0,0 +1,61 @@
+package shamhub
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os/exec"
+
+	"go.abhg.dev/gs/internal/silog"
+)
+
+var _ = shamhubRESTHandler("GET /{owner}/{repo}/file", (*ShamHub).handleReadFile)
+
+type readFileRequest struct {
+	Owner string `path:"owner" json:"-"`
+	Repo  string `path:"repo" json:"-"`
+
+	Ref  string `form:"ref,required" json:"-"`
+	Path string `form:"path,required" json:"-"`
+}
+
+type readFileResponse struct {
+	// Content is the base64-encoded contents of the file.
+	Content string `json:"content,omitempty"`
+}
+
+func (sh *ShamHub) handleReadFile(ctx context.Context, req *readFileRequest) (*readFileResponse, error) {
+	logw, flush := silog.Writer(sh.log, silog.LevelDebug)
+	defer flush()
+
+	cmd := exec.CommandContext(ctx, sh.gitExe, "show", req.Ref+":"+req.Path)
+	cmd.Dir = sh.repoDir(req.Owner, req.Repo)
+	cmd.Stderr = logw
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, notFoundErrorf("read %q at %q: %v", req.Path, req.Ref, err)
+	}
+
+	return &readFileResponse{Content: base64.StdEncoding.EncodeToString(out)}, nil
+}
+
+func (r *forgeRepository) ReadFile(ctx context.Context, ref, path string) ([]byte, error) {
+	u := r.apiURL.JoinPath(r.owner, r.repo, "file")
+	q := u.Query()
+	q.Set("ref", ref)
+	q.Set("path", path)
+	u.RawQuery = q.Encode()
+
+	var res readFileResponse
+	if err := r.client.Get(ctx, u.String(), &res); err != nil {
+		return nil, fmt.Errorf("read %q at %q: %w", path, ref, err)
+	}
+
+	content, err := base64.StdEncoding.DecodeString(res.Content)
+	if err != nil {
+		return nil, fmt.Errorf("read %q at %q: decode content: %w", path, ref, err)
+	}
+
+	return content, nil
+}
@@ -142,6 +142,7 @@ func (r *forgeRepository) FindChangeByID(ctx context.Context, fid forge.ChangeID
 		Draft:    res.Draft,
 		State:    state,
 		Labels:   labels,
+		Verified: res.Verified,
 	}, nil
 }
 
@@ -193,6 +194,7 @@ func (r *forgeRepository) FindChangesByBranch(ctx context.Context, branch string
 			BaseName: c.Base.Name,
 			Draft:    c.Draft,
 			Labels:   labels,
+			Verified: c.Verified,
 		}
 	}
 	return changes, nil
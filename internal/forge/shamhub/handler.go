@@ -249,18 +249,32 @@ func (sh *ShamHub) apiHandler() http.Handler {
 			}
 
 			sh.mu.RLock()
-			_, ok := sh.tokens[token]
+			username, ok := sh.tokens[token]
 			sh.mu.RUnlock()
 			if !ok {
 				http.Error(w, "invalid token", http.StatusUnauthorized)
 				return
 			}
+
+			r = r.WithContext(context.WithValue(r.Context(), _currentUserKey{}, username))
 		}
 
 		mux.ServeHTTP(w, r)
 	})
 }
 
+// _currentUserKey is the context key under which the username associated
+// with the request's Authentication-Token is stored.
+type _currentUserKey struct{}
+
+// currentUser reports the username associated with the authenticated
+// request that ctx was derived from, or "" if there isn't one
+// (e.g. the /login endpoint).
+func currentUser(ctx context.Context) string {
+	username, _ := ctx.Value(_currentUserKey{}).(string)
+	return username
+}
+
 // httpError allows handlers to return specific HTTP status codes
 type httpError struct {
 	code    int
@@ -155,8 +155,9 @@ func TestIntegration(t *testing.T) {
 				}))
 			}
 		},
-		SetCommentsPageSize: SetListChangeCommentsPageSize,
-		Reviewers:           []string{"reviewer-user"},
-		Assignees:           []string{"assignee-user"},
+		SetCommentsPageSize:   SetListChangeCommentsPageSize,
+		Reviewers:             []string{"reviewer-user"},
+		Assignees:             []string{"assignee-user"},
+		SupportsSignedCommits: true,
 	})
 }
@@ -1,95 +1,16 @@
 package shamhub
 
 import (
-	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
 	"os"
 	"os/exec"
 	"strings"
 	"time"
 
-	"go.abhg.dev/gs/internal/forge"
 	"go.abhg.dev/gs/internal/log"
 )
 
-type areMergedRequest struct {
-	IDs []ChangeID `json:"ids"`
-}
-
-type areMergedResponse struct {
-	Merged []bool `json:"merged"`
-}
-
-var _ = shamhubHandler("POST /{owner}/{repo}/change/merged", (*ShamHub).handleAreMerged)
-
-func (sh *ShamHub) handleAreMerged(w http.ResponseWriter, r *http.Request) {
-	owner, repo := r.PathValue("owner"), r.PathValue("repo")
-	if owner == "" || repo == "" {
-		http.Error(w, "owner, repo, and number are required", http.StatusBadRequest)
-		return
-	}
-
-	var req areMergedRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
-
-	changeNumToIdx := make(map[int]int, len(req.IDs))
-	for i, id := range req.IDs {
-		changeNumToIdx[int(id)] = i
-	}
-
-	sh.mu.RLock()
-	merged := make([]bool, len(changeNumToIdx))
-	for _, c := range sh.changes {
-		if c.Owner == owner && c.Repo == repo {
-			idx, ok := changeNumToIdx[c.Number]
-			if !ok {
-				continue
-			}
-			merged[idx] = c.State == shamChangeMerged
-			delete(changeNumToIdx, c.Number)
-
-			if len(changeNumToIdx) == 0 {
-				break
-			}
-		}
-	}
-	sh.mu.RUnlock()
-
-	if len(changeNumToIdx) > 0 {
-		w.WriteHeader(http.StatusNotFound)
-		fmt.Fprintf(w, "changes not found: %v", changeNumToIdx)
-		return
-	}
-
-	enc := json.NewEncoder(w)
-	enc.SetIndent("", "  ")
-	if err := enc.Encode(areMergedResponse{Merged: merged}); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-	}
-}
-
-func (r *forgeRepository) ChangesAreMerged(ctx context.Context, fids []forge.ChangeID) ([]bool, error) {
-	ids := make([]ChangeID, len(fids))
-	for i, fid := range fids {
-		ids[i] = fid.(ChangeID)
-	}
-
-	u := r.apiURL.JoinPath(r.owner, r.repo, "change", "merged")
-	req := areMergedRequest{IDs: ids}
-
-	var res areMergedResponse
-	if err := r.client.Post(ctx, u.String(), req, &res); err != nil {
-		return nil, fmt.Errorf("are merged: %w", err)
-	}
-	return res.Merged, nil
-}
-
 // MergeChangeRequest is a request to merge an open change
 // proposed against this forge.
 type MergeChangeRequest struct {
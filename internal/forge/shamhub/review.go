@@ -0,0 +1,180 @@
+package shamhub
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strconv"
+	"time"
+
+	"go.abhg.dev/gs/internal/forge"
+)
+
+// shamReview is a review left on a change in ShamHub.
+type shamReview struct {
+	ID          int
+	Change      int
+	Reviewer    string
+	State       forge.ReviewState
+	Body        string
+	SubmittedAt time.Time
+}
+
+var (
+	_ = shamhubRESTHandler("POST /{owner}/{repo}/reviews", (*ShamHub).handleSubmitReview)
+	_ = shamhubRESTHandler("GET /{owner}/{repo}/reviews", (*ShamHub).handleListReviews)
+)
+
+type submitReviewRequest struct {
+	Owner string `path:"owner" json:"-"`
+	Repo  string `path:"repo" json:"-"`
+
+	Change int    `json:"changeNumber,omitempty"`
+	State  string `json:"state,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+type submitReviewResponse struct{}
+
+func (sh *ShamHub) handleSubmitReview(ctx context.Context, req *submitReviewRequest) (*submitReviewResponse, error) {
+	owner, repo := req.Owner, req.Repo
+
+	sh.mu.RLock()
+	var found bool
+	for _, c := range sh.changes {
+		if c.Base.Owner == owner && c.Base.Repo == repo && c.Number == req.Change {
+			found = true
+			break
+		}
+	}
+	sh.mu.RUnlock()
+
+	if !found {
+		return nil, notFoundErrorf("change %d not found in %s/%s", req.Change, owner, repo)
+	}
+
+	var state forge.ReviewState
+	if err := state.UnmarshalText([]byte(req.State)); err != nil {
+		return nil, badRequestErrorf("invalid review state %q: %v", req.State, err)
+	}
+
+	sh.mu.Lock()
+	sh.reviews = append(sh.reviews, shamReview{
+		ID:          len(sh.reviews) + 1,
+		Change:      req.Change,
+		Reviewer:    currentUser(ctx),
+		State:       state,
+		Body:        req.Body,
+		SubmittedAt: time.Now(),
+	})
+	sh.mu.Unlock()
+
+	return &submitReviewResponse{}, nil
+}
+
+func (r *forgeRepository) SubmitReview(
+	ctx context.Context,
+	id forge.ChangeID,
+	req forge.SubmitReviewRequest,
+) error {
+	stateText, err := req.State.MarshalText()
+	if err != nil {
+		return fmt.Errorf("submit review: %w", err)
+	}
+
+	u := r.apiURL.JoinPath(r.owner, r.repo, "reviews")
+	httpReq := submitReviewRequest{
+		Change: int(id.(ChangeID)),
+		State:  string(stateText),
+		Body:   req.Body,
+	}
+
+	var res submitReviewResponse
+	if err := r.client.Post(ctx, u.String(), httpReq, &res); err != nil {
+		return fmt.Errorf("submit review: %w", err)
+	}
+
+	return nil
+}
+
+type listReviewsRequest struct {
+	Owner  string `path:"owner" json:"-"`
+	Repo   string `path:"repo" json:"-"`
+	Change int    `form:"change,required" json:"-"`
+}
+
+type listReviewsResponse struct {
+	Items []listReviewsItem `json:"items,omitempty"`
+}
+
+type listReviewsItem struct {
+	Reviewer    string    `json:"reviewer,omitempty"`
+	State       string    `json:"state,omitempty"`
+	Body        string    `json:"body,omitempty"`
+	SubmittedAt time.Time `json:"submitted_at,omitempty"`
+}
+
+func (sh *ShamHub) handleListReviews(_ context.Context, req *listReviewsRequest) (*listReviewsResponse, error) {
+	// owner/repo not really used because change numbers are globally unique.
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+
+	var items []listReviewsItem
+	for _, rev := range sh.reviews {
+		if rev.Change != req.Change {
+			continue
+		}
+
+		stateText, err := rev.State.MarshalText()
+		if err != nil {
+			return nil, fmt.Errorf("marshal review state: %w", err)
+		}
+
+		items = append(items, listReviewsItem{
+			Reviewer:    rev.Reviewer,
+			State:       string(stateText),
+			Body:        rev.Body,
+			SubmittedAt: rev.SubmittedAt,
+		})
+	}
+
+	return &listReviewsResponse{Items: items}, nil
+}
+
+func (r *forgeRepository) ListChangeReviews(
+	ctx context.Context,
+	id forge.ChangeID,
+) iter.Seq2[*forge.Review, error] {
+	u := r.apiURL.JoinPath(r.owner, r.repo, "reviews")
+	q := u.Query()
+	q.Set("change", strconv.Itoa(int(id.(ChangeID))))
+	u.RawQuery = q.Encode()
+
+	return func(yield func(*forge.Review, error) bool) {
+		var res listReviewsResponse
+		if err := r.client.Get(ctx, u.String(), &res); err != nil {
+			yield(nil, fmt.Errorf("list reviews: %w", err))
+			return
+		}
+
+		for _, item := range res.Items {
+			var state forge.ReviewState
+			if err := state.UnmarshalText([]byte(item.State)); err != nil {
+				if !yield(nil, fmt.Errorf("parse review state: %w", err)) {
+					return
+				}
+				continue
+			}
+
+			review := &forge.Review{
+				Reviewer:    item.Reviewer,
+				State:       state,
+				Body:        item.Body,
+				SubmittedAt: item.SubmittedAt,
+			}
+			if !yield(review, nil) {
+				return
+			}
+		}
+	}
+}
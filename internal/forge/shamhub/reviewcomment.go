@@ -0,0 +1,267 @@
+package shamhub
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strconv"
+
+	"go.abhg.dev/gs/internal/forge"
+)
+
+// shamReviewComment is a review comment left on a change in ShamHub.
+type shamReviewComment struct {
+	ID        int
+	Change    int
+	Path      string
+	Line      int
+	Side      forge.ReviewCommentSide
+	Body      string
+	InReplyTo int // zero if this starts a new thread
+}
+
+var (
+	_ = shamhubRESTHandler("POST /{owner}/{repo}/reviewcomments", (*ShamHub).handlePostReviewComment)
+	_ = shamhubRESTHandler("PATCH /{owner}/{repo}/reviewcomments/{id}", (*ShamHub).handleUpdateReviewComment)
+	_ = shamhubRESTHandler("GET /{owner}/{repo}/reviewcomments", (*ShamHub).handleListReviewComments)
+)
+
+type postReviewCommentRequest struct {
+	Owner string `path:"owner" json:"-"`
+	Repo  string `path:"repo" json:"-"`
+
+	Change    int    `json:"changeNumber,omitempty"`
+	Path      string `json:"path,omitempty"`
+	Line      int    `json:"line,omitempty"`
+	Side      string `json:"side,omitempty"`
+	Body      string `json:"body,omitempty"`
+	InReplyTo int    `json:"inReplyTo,omitempty"`
+}
+
+type postReviewCommentResponse struct {
+	ID int `json:"id,omitempty"`
+}
+
+func (sh *ShamHub) handlePostReviewComment(_ context.Context, req *postReviewCommentRequest) (*postReviewCommentResponse, error) {
+	owner, repo := req.Owner, req.Repo
+
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	var found bool
+	for _, c := range sh.changes {
+		if c.Base.Owner == owner && c.Base.Repo == repo && c.Number == req.Change {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, notFoundErrorf("change %d not found in %s/%s", req.Change, owner, repo)
+	}
+
+	comment := shamReviewComment{
+		ID:        len(sh.reviewComments) + 1,
+		Change:    req.Change,
+		InReplyTo: req.InReplyTo,
+	}
+
+	if req.InReplyTo != 0 {
+		var parent *shamReviewComment
+		for i, rc := range sh.reviewComments {
+			if rc.ID == req.InReplyTo {
+				parent = &sh.reviewComments[i]
+				break
+			}
+		}
+		if parent == nil {
+			return nil, notFoundErrorf("review comment %d not found", req.InReplyTo)
+		}
+		comment.Path, comment.Line, comment.Side = parent.Path, parent.Line, parent.Side
+	} else {
+		var side forge.ReviewCommentSide
+		if err := side.UnmarshalText([]byte(req.Side)); err != nil {
+			return nil, badRequestErrorf("invalid review comment side %q: %v", req.Side, err)
+		}
+		comment.Path, comment.Line, comment.Side = req.Path, req.Line, side
+	}
+	comment.Body = req.Body
+
+	sh.reviewComments = append(sh.reviewComments, comment)
+
+	return &postReviewCommentResponse{ID: comment.ID}, nil
+}
+
+type updateReviewCommentRequest struct {
+	Owner string `path:"owner" json:"-"`
+	Repo  string `path:"repo" json:"-"`
+	ID    int    `path:"id" json:"-"`
+
+	Body string `json:"body,omitempty"`
+}
+
+type updateReviewCommentResponse struct {
+	ID int `json:"id,omitempty"`
+}
+
+func (sh *ShamHub) handleUpdateReviewComment(_ context.Context, req *updateReviewCommentRequest) (*updateReviewCommentResponse, error) {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	for i, rc := range sh.reviewComments {
+		if rc.ID == req.ID {
+			sh.reviewComments[i].Body = req.Body
+			return &updateReviewCommentResponse{ID: req.ID}, nil
+		}
+	}
+
+	return nil, notFoundErrorf("review comment %d not found in %s/%s", req.ID, req.Owner, req.Repo)
+}
+
+type listReviewCommentsRequest struct {
+	Owner  string `path:"owner" json:"-"`
+	Repo   string `path:"repo" json:"-"`
+	Change int    `form:"change,required" json:"-"`
+	Path   string `form:"path" json:"-"`
+}
+
+type listReviewCommentsResponse struct {
+	Items []listReviewCommentsItem `json:"items,omitempty"`
+}
+
+type listReviewCommentsItem struct {
+	ID        int    `json:"id,omitempty"`
+	Path      string `json:"path,omitempty"`
+	Line      int    `json:"line,omitempty"`
+	Side      string `json:"side,omitempty"`
+	Body      string `json:"body,omitempty"`
+	InReplyTo int    `json:"inReplyTo,omitempty"`
+}
+
+func (sh *ShamHub) handleListReviewComments(_ context.Context, req *listReviewCommentsRequest) (*listReviewCommentsResponse, error) {
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+
+	var items []listReviewCommentsItem
+	for _, rc := range sh.reviewComments {
+		if rc.Change != req.Change {
+			continue
+		}
+		if req.Path != "" && rc.Path != req.Path {
+			continue
+		}
+
+		sideText, err := rc.Side.MarshalText()
+		if err != nil {
+			return nil, fmt.Errorf("marshal review comment side: %w", err)
+		}
+
+		items = append(items, listReviewCommentsItem{
+			ID:        rc.ID,
+			Path:      rc.Path,
+			Line:      rc.Line,
+			Side:      string(sideText),
+			Body:      rc.Body,
+			InReplyTo: rc.InReplyTo,
+		})
+	}
+
+	return &listReviewCommentsResponse{Items: items}, nil
+}
+
+func (r *forgeRepository) PostChangeReviewComment(
+	ctx context.Context,
+	id forge.ChangeID,
+	req forge.ReviewCommentRequest,
+) (forge.ChangeCommentID, error) {
+	httpReq := postReviewCommentRequest{
+		Change: int(id.(ChangeID)),
+		Path:   req.Path,
+		Line:   req.Line,
+		Body:   req.Body,
+	}
+
+	if req.InReplyTo != nil {
+		httpReq.InReplyTo = int(req.InReplyTo.(ChangeCommentID))
+	} else {
+		side := req.Side
+		if side == 0 {
+			side = forge.ReviewCommentSideRight
+		}
+		sideText, err := side.MarshalText()
+		if err != nil {
+			return nil, fmt.Errorf("post review comment: %w", err)
+		}
+		httpReq.Side = string(sideText)
+	}
+
+	u := r.apiURL.JoinPath(r.owner, r.repo, "reviewcomments")
+	var res postReviewCommentResponse
+	if err := r.client.Post(ctx, u.String(), httpReq, &res); err != nil {
+		return nil, fmt.Errorf("post review comment: %w", err)
+	}
+
+	return ChangeCommentID(res.ID), nil
+}
+
+func (r *forgeRepository) UpdateChangeReviewComment(
+	ctx context.Context,
+	id forge.ChangeCommentID,
+	markdown string,
+) error {
+	cid := int(id.(ChangeCommentID))
+	u := r.apiURL.JoinPath(r.owner, r.repo, "reviewcomments", strconv.Itoa(cid))
+	req := updateReviewCommentRequest{Body: markdown}
+	var res updateReviewCommentResponse
+	if err := r.client.Patch(ctx, u.String(), req, &res); err != nil {
+		return fmt.Errorf("update review comment: %w", err)
+	}
+
+	return nil
+}
+
+func (r *forgeRepository) ListChangeReviewComments(
+	ctx context.Context,
+	id forge.ChangeID,
+	opts *forge.ListChangeReviewCommentsOptions,
+) iter.Seq2[*forge.ReviewComment, error] {
+	u := r.apiURL.JoinPath(r.owner, r.repo, "reviewcomments")
+	q := u.Query()
+	q.Set("change", strconv.Itoa(int(id.(ChangeID))))
+	if opts != nil && opts.Path != "" {
+		q.Set("path", opts.Path)
+	}
+	u.RawQuery = q.Encode()
+
+	return func(yield func(*forge.ReviewComment, error) bool) {
+		var res listReviewCommentsResponse
+		if err := r.client.Get(ctx, u.String(), &res); err != nil {
+			yield(nil, fmt.Errorf("list review comments: %w", err))
+			return
+		}
+
+		for _, item := range res.Items {
+			var side forge.ReviewCommentSide
+			if err := side.UnmarshalText([]byte(item.Side)); err != nil {
+				if !yield(nil, fmt.Errorf("parse review comment side: %w", err)) {
+					return
+				}
+				continue
+			}
+
+			comment := &forge.ReviewComment{
+				ID:   ChangeCommentID(item.ID),
+				Path: item.Path,
+				Line: item.Line,
+				Side: side,
+				Body: item.Body,
+			}
+			if item.InReplyTo != 0 {
+				comment.InReplyTo = ChangeCommentID(item.InReplyTo)
+			}
+
+			if !yield(comment, nil) {
+				return
+			}
+		}
+	}
+}
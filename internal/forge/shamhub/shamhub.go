@@ -34,10 +34,12 @@ type ShamHub struct {
 	apiServer *httptest.Server // API server
 	gitServer *httptest.Server // Git HTTP remote
 
-	mu       sync.RWMutex
-	changes  []shamChange  // all changes
-	users    []shamUser    // all users
-	comments []shamComment // all comments
+	mu             sync.RWMutex
+	changes        []shamChange        // all changes
+	users          []shamUser          // all users
+	comments       []shamComment       // all comments
+	reviews        []shamReview        // all reviews
+	reviewComments []shamReviewComment // all review comments
 
 	tokens map[string]string // token -> username
 }
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"go.abhg.dev/gs/internal/forge"
+	"go.abhg.dev/gs/internal/git"
 	"go.abhg.dev/gs/internal/silog"
 )
 
@@ -19,8 +20,19 @@ type statesRequest struct {
 	IDs []ChangeID `json:"ids"`
 }
 
+// changeStatusDTO is the wire representation of a single
+// [forge.ChangeStatus] result.
+type changeStatusDTO struct {
+	State       string    `json:"state"`
+	HeadSHA     string    `json:"head_sha,omitempty"`
+	BaseRef     string    `json:"base_ref,omitempty"`
+	Draft       bool      `json:"draft,omitempty"`
+	MergedAt    time.Time `json:"merged_at,omitempty"`
+	MergeCommit string    `json:"merge_commit,omitempty"`
+}
+
 type statesResponse struct {
-	States []string `json:"states"`
+	Statuses []changeStatusDTO `json:"statuses"`
 }
 
 var _ = shamhubHandler("POST /{owner}/{repo}/change/states", (*ShamHub).handleStates)
@@ -43,22 +55,41 @@ func (sh *ShamHub) handleStates(w http.ResponseWriter, r *http.Request) {
 		changeNumToIdx[int(id)] = i
 	}
 
+	// Changes not found among sh.changes are reported as "not_found"
+	// rather than failing the whole request.
+	statuses := make([]changeStatusDTO, len(req.IDs))
+	for i := range statuses {
+		statuses[i] = changeStatusDTO{State: "not_found"}
+	}
+
 	sh.mu.RLock()
-	states := make([]string, len(changeNumToIdx))
 	for _, c := range sh.changes {
 		if c.Base.Owner == owner && c.Base.Repo == repo {
 			idx, ok := changeNumToIdx[c.Number]
 			if !ok {
 				continue
 			}
+
+			dto := changeStatusDTO{
+				BaseRef: c.Base.Name,
+				Draft:   c.Draft,
+			}
 			switch c.State {
 			case shamChangeOpen:
-				states[idx] = "open"
+				dto.State = "open"
 			case shamChangeClosed:
-				states[idx] = "closed"
+				dto.State = "closed"
 			case shamChangeMerged:
-				states[idx] = "merged"
+				dto.State = "merged"
+				dto.MergedAt = c.MergedAt
+				dto.MergeCommit = c.MergeCommitHash
+			}
+			if head, err := sh.toChangeBranch(c.Head); err == nil {
+				dto.HeadSHA = head.Hash
+			} else {
+				sh.log.Warnf("resolve head SHA for change %d: %v", c.Number, err)
 			}
+			statuses[idx] = dto
 			delete(changeNumToIdx, c.Number)
 
 			if len(changeNumToIdx) == 0 {
@@ -68,20 +99,15 @@ func (sh *ShamHub) handleStates(w http.ResponseWriter, r *http.Request) {
 	}
 	sh.mu.RUnlock()
 
-	if len(changeNumToIdx) > 0 {
-		w.WriteHeader(http.StatusNotFound)
-		fmt.Fprintf(w, "changes not found: %v", changeNumToIdx)
-		return
-	}
-
 	enc := json.NewEncoder(w)
 	enc.SetIndent("", "  ")
-	if err := enc.Encode(statesResponse{States: states}); err != nil {
+	if err := enc.Encode(statesResponse{Statuses: statuses}); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 	}
 }
 
-func (r *forgeRepository) ChangesStates(ctx context.Context, fids []forge.ChangeID) ([]forge.ChangeState, error) {
+// ChangeStatuses retrieves the status of the given changes in bulk.
+func (r *forgeRepository) ChangeStatuses(ctx context.Context, fids []forge.ChangeID) ([]*forge.ChangeStatus, error) {
 	ids := make([]ChangeID, len(fids))
 	for i, fid := range fids {
 		ids[i] = fid.(ChangeID)
@@ -92,24 +118,34 @@ func (r *forgeRepository) ChangesStates(ctx context.Context, fids []forge.Change
 
 	var res statesResponse
 	if err := r.client.Post(ctx, u.String(), req, &res); err != nil {
-		return nil, fmt.Errorf("get states: %w", err)
+		return nil, fmt.Errorf("get statuses: %w", err)
 	}
 
-	states := make([]forge.ChangeState, len(res.States))
-	for i, state := range res.States {
-		switch state {
+	statuses := make([]*forge.ChangeStatus, len(res.Statuses))
+	for i, dto := range res.Statuses {
+		status := &forge.ChangeStatus{
+			HeadSHA:     git.Hash(dto.HeadSHA),
+			BaseRef:     dto.BaseRef,
+			IsDraft:     dto.Draft,
+			MergedAt:    dto.MergedAt,
+			MergeCommit: git.Hash(dto.MergeCommit),
+		}
+		switch dto.State {
 		case "open":
-			states[i] = forge.ChangeOpen
+			status.State = forge.ChangeOpen
 		case "closed":
-			states[i] = forge.ChangeClosed
+			status.State = forge.ChangeClosed
 		case "merged":
-			states[i] = forge.ChangeMerged
+			status.State = forge.ChangeMerged
+		case "not_found":
+			status.State = forge.ChangeNotFound
 		default:
-			states[i] = forge.ChangeOpen // default to open for unknown states
+			status.State = forge.ChangeOpen // default to open for unknown states
 		}
+		statuses[i] = status
 	}
 
-	return states, nil
+	return statuses, nil
 }
 
 // MergeChangeRequest is a request to merge an open change
@@ -301,6 +337,13 @@ func (sh *ShamHub) MergeChange(req MergeChangeRequest) error {
 		}
 	}
 
+	mergedAt := req.Time
+	if mergedAt.IsZero() {
+		mergedAt = time.Now()
+	}
+
 	sh.changes[changeIdx].State = shamChangeMerged
+	sh.changes[changeIdx].MergedAt = mergedAt
+	sh.changes[changeIdx].MergeCommitHash = commit
 	return nil
 }
@@ -129,3 +129,84 @@ func Print[N Node](w io.Writer, nodes []N, currentIdx int, opts *PrintOptions) {
 	// Current branch and its upstacks.
 	visit(currentIdx, indent)
 }
+
+// PrintMermaid visualizes a stack of changes in a Forge
+// as a Mermaid flowchart ("graph TD").
+//
+// For example:
+//
+//	graph TD
+//	    n0["#123"]
+//	    n1["#124 ◀"]
+//	    n2["#125"]
+//	    n0 --> n1
+//	    n1 --> n2
+//
+// The result can be pasted into a GitHub, GitLab, or Bitbucket
+// Markdown description, and will render as a diagram.
+//
+// currentIdx and opts behave as they do for [Print].
+//
+// All Write errors are ignored. Use a Writer that doesn't fail.
+func PrintMermaid[N Node](w io.Writer, nodes []N, currentIdx int, opts *PrintOptions) {
+	marker := _marker
+	if opts != nil && opts.Marker != "" {
+		marker = opts.Marker
+	}
+
+	_, _ = io.WriteString(w, "graph TD\n")
+	for idx, node := range nodes {
+		label := node.Value()
+		if idx == currentIdx {
+			label += " " + marker
+		}
+		_, _ = fmt.Fprintf(w, "    n%d[%q]\n", idx, label)
+	}
+	for idx, node := range nodes {
+		if baseIdx := node.BaseIdx(); baseIdx >= 0 {
+			_, _ = fmt.Fprintf(w, "    n%d --> n%d\n", baseIdx, idx)
+		}
+	}
+}
+
+// PrintDOT visualizes a stack of changes in a Forge
+// as a Graphviz DOT digraph.
+//
+// For example:
+//
+//	digraph stack {
+//	    rankdir=BT;
+//	    n0 [label="#123"];
+//	    n1 [label="#124 ◀"];
+//	    n2 [label="#125"];
+//	    n0 -> n1;
+//	    n1 -> n2;
+//	}
+//
+// currentIdx and opts behave as they do for [Print].
+//
+// All Write errors are ignored. Use a Writer that doesn't fail.
+func PrintDOT[N Node](w io.Writer, nodes []N, currentIdx int, opts *PrintOptions) {
+	marker := _marker
+	if opts != nil && opts.Marker != "" {
+		marker = opts.Marker
+	}
+
+	_, _ = io.WriteString(w, "digraph stack {\n")
+	// Base branches point up at the branches stacked on them,
+	// so draw the graph bottom-to-top to match how a stack is usually pictured.
+	_, _ = io.WriteString(w, "    rankdir=BT;\n")
+	for idx, node := range nodes {
+		label := node.Value()
+		if idx == currentIdx {
+			label += " " + marker
+		}
+		_, _ = fmt.Fprintf(w, "    n%d [label=%q];\n", idx, label)
+	}
+	for idx, node := range nodes {
+		if baseIdx := node.BaseIdx(); baseIdx >= 0 {
+			_, _ = fmt.Fprintf(w, "    n%d -> n%d;\n", baseIdx, idx)
+		}
+	}
+	_, _ = io.WriteString(w, "}\n")
+}
@@ -99,6 +99,102 @@ func TestPrinter(t *testing.T) {
 	}
 }
 
+func TestPrintMermaid(t *testing.T) {
+	tests := []struct {
+		name    string
+		graph   []Item
+		current int
+		want    string
+	}{
+		{
+			name: "Single",
+			graph: []Item{
+				{value: "#123", base: -1},
+			},
+			current: 0,
+			want: joinLines(
+				"graph TD",
+				`    n0["#123 ◀"]`,
+			),
+		},
+		{
+			name: "Upstack/NonLinear",
+			graph: []Item{
+				{value: "#123", base: -1},
+				{value: "#124", base: 0},
+				{value: "#125", base: 0},
+			},
+			current: 0,
+			want: joinLines(
+				"graph TD",
+				`    n0["#123 ◀"]`,
+				`    n1["#124"]`,
+				`    n2["#125"]`,
+				"    n0 --> n1",
+				"    n0 --> n2",
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got strings.Builder
+			PrintMermaid(&got, tt.graph, tt.current, nil)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestPrintDOT(t *testing.T) {
+	tests := []struct {
+		name    string
+		graph   []Item
+		current int
+		want    string
+	}{
+		{
+			name: "Single",
+			graph: []Item{
+				{value: "#123", base: -1},
+			},
+			current: 0,
+			want: joinLines(
+				"digraph stack {",
+				"    rankdir=BT;",
+				`    n0 [label="#123 ◀"];`,
+				"}",
+			),
+		},
+		{
+			name: "Upstack/NonLinear",
+			graph: []Item{
+				{value: "#123", base: -1},
+				{value: "#124", base: 0},
+				{value: "#125", base: 0},
+			},
+			current: 0,
+			want: joinLines(
+				"digraph stack {",
+				"    rankdir=BT;",
+				`    n0 [label="#123 ◀"];`,
+				`    n1 [label="#124"];`,
+				`    n2 [label="#125"];`,
+				"    n0 -> n1;",
+				"    n0 -> n2;",
+				"}",
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got strings.Builder
+			PrintDOT(&got, tt.graph, tt.current, nil)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
 type Item struct {
 	value string
 	base  int
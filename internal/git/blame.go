@@ -0,0 +1,236 @@
+package git
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.abhg.dev/gs/internal/sliceutil"
+)
+
+// CommitMeta holds the commit metadata that [Repository.BlameHunks]
+// reports for the commit that introduced a hunk.
+type CommitMeta struct {
+	// Hash is the hash of the commit.
+	Hash Hash
+
+	// Author is the commit's author.
+	Author Signature
+
+	// Committer is the commit's committer.
+	Committer Signature
+
+	// Summary is the first line of the commit message.
+	Summary string
+
+	// Boundary reports whether this is a boundary commit:
+	// one beyond which blame does not attribute further history,
+	// e.g. because of a shallow clone or a revision range limit.
+	Boundary bool
+}
+
+// BlameHunk is a contiguous range of lines in a blamed file
+// attributed to a single commit.
+type BlameHunk struct {
+	// Commit is the commit that introduced this hunk.
+	Commit *CommitMeta
+
+	// OrigLine is the 1-indexed line at which the hunk starts
+	// in Commit's version of the file.
+	OrigLine int
+
+	// FinalLine is the 1-indexed line at which the hunk starts
+	// in the version of the file being blamed.
+	FinalLine int
+
+	// LineCount is the number of lines in the hunk.
+	LineCount int
+}
+
+// BlameRequest configures a [Repository.Blame] or [Repository.BlameHunks] call.
+type BlameRequest struct {
+	// Path is the path of the file to blame,
+	// relative to the repository root.
+	Path string // required
+
+	// Revision is the commit-ish to blame from.
+	// Defaults to the working tree if empty.
+	Revision string
+}
+
+// BlameResult is the full output of [Repository.Blame].
+type BlameResult struct {
+	// Hunks are the blamed file's hunks,
+	// in the order git blame reported them.
+	Hunks []BlameHunk
+}
+
+// Blame attributes every line of the file at req.Path to the commit
+// that last changed it.
+//
+// For large files, or callers that want to render hunks as they
+// arrive instead of waiting for the whole file, use
+// [Repository.BlameHunks] instead.
+func (r *Repository) Blame(ctx context.Context, req BlameRequest) (*BlameResult, error) {
+	hunks, err := sliceutil.CollectErr(r.BlameHunks(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+	return &BlameResult{Hunks: hunks}, nil
+}
+
+// BlameHunks streams the hunks of the file at req.Path, attributing
+// each one to the commit that introduced it.
+//
+// It wraps `git blame --porcelain --incremental`, which reports hunks
+// as soon as their commit is found rather than waiting for the whole
+// file, so large files can be rendered progressively. A commit's full
+// metadata (author, summary, etc.) is only printed the first time that
+// commit is referenced; BlameHunks fills it in from a small in-memory
+// cache for subsequent hunks attributed to the same commit, so every
+// yielded [BlameHunk] carries complete [CommitMeta] regardless of
+// where in the stream it appears.
+func (r *Repository) BlameHunks(ctx context.Context, req BlameRequest) iter.Seq2[BlameHunk, error] {
+	args := []string{"blame", "--porcelain", "--incremental"}
+	if req.Revision != "" {
+		args = append(args, req.Revision)
+	}
+	args = append(args, "--", req.Path)
+
+	return func(yield func(BlameHunk, error) bool) {
+		cmd := r.gitCmd(ctx, args...)
+		out, err := cmd.StdoutPipe()
+		if err != nil {
+			yield(BlameHunk{}, fmt.Errorf("pipe stdout: %w", err))
+			return
+		}
+
+		if err := cmd.Start(r.exec); err != nil {
+			yield(BlameHunk{}, fmt.Errorf("start: %w", err))
+			return
+		}
+		var finished bool
+		defer func() {
+			if !finished {
+				_ = cmd.Kill(r.exec)
+			}
+		}()
+
+		commits := make(map[Hash]*CommitMeta)
+		scanner := bufio.NewScanner(out)
+		scanner.Buffer(make([]byte, 4096), 1<<20)
+
+		for scanner.Scan() {
+			hunk, meta, ok := parseBlameHeader(scanner.Text())
+			if !ok {
+				r.log.Warnf("blame: skipping malformed header: %q", scanner.Text())
+				continue
+			}
+
+			if known, ok := commits[meta.Hash]; ok {
+				meta = known
+			} else {
+				commits[meta.Hash] = meta
+			}
+
+			for scanner.Scan() {
+				line := scanner.Text()
+				if strings.HasPrefix(line, "filename ") {
+					break
+				}
+				parseBlameMetaLine(meta, line)
+			}
+
+			hunk.Commit = meta
+			if !yield(hunk, nil) {
+				return
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			yield(BlameHunk{}, fmt.Errorf("scan: %w", err))
+			return
+		}
+
+		if err := cmd.Wait(r.exec); err != nil {
+			yield(BlameHunk{}, fmt.Errorf("git blame: %w", err))
+			return
+		}
+
+		finished = true
+	}
+}
+
+// parseBlameHeader parses a hunk header line of the form:
+//
+//	<hash> <orig-line> <final-line> <num-lines>
+//
+// It returns the hunk (without its Commit set) and a CommitMeta
+// carrying just the hash, ready to either be filled in by subsequent
+// parseBlameMetaLine calls, or discarded in favor of a cached one.
+func parseBlameHeader(line string) (BlameHunk, *CommitMeta, bool) {
+	fields := strings.Fields(line)
+	if len(fields) != 4 {
+		return BlameHunk{}, nil, false
+	}
+
+	orig, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return BlameHunk{}, nil, false
+	}
+	final, err := strconv.Atoi(fields[2])
+	if err != nil {
+		return BlameHunk{}, nil, false
+	}
+	count, err := strconv.Atoi(fields[3])
+	if err != nil {
+		return BlameHunk{}, nil, false
+	}
+
+	return BlameHunk{
+		OrigLine:  orig,
+		FinalLine: final,
+		LineCount: count,
+	}, &CommitMeta{Hash: Hash(fields[0])}, true
+}
+
+// parseBlameMetaLine applies a single porcelain metadata line
+// (e.g. "author Jane Doe") to meta.
+//
+// Lines this package has no use for, such as "previous", are ignored.
+func parseBlameMetaLine(meta *CommitMeta, line string) {
+	switch {
+	case line == "boundary":
+		meta.Boundary = true
+	case strings.HasPrefix(line, "author-mail "):
+		meta.Author.Email = strings.Trim(line[len("author-mail "):], "<>")
+	case strings.HasPrefix(line, "author-time "):
+		meta.Author.Time = parseBlameTime(line[len("author-time "):])
+	case strings.HasPrefix(line, "author "):
+		meta.Author.Name = line[len("author "):]
+	case strings.HasPrefix(line, "committer-mail "):
+		meta.Committer.Email = strings.Trim(line[len("committer-mail "):], "<>")
+	case strings.HasPrefix(line, "committer-time "):
+		meta.Committer.Time = parseBlameTime(line[len("committer-time "):])
+	case strings.HasPrefix(line, "committer "):
+		meta.Committer.Name = line[len("committer "):]
+	case strings.HasPrefix(line, "summary "):
+		meta.Summary = line[len("summary "):]
+	}
+}
+
+// parseBlameTime parses a porcelain "*-time" value, a Unix timestamp
+// in seconds. The author/committer-tz lines that accompany it only
+// affect how the instant is displayed, not the instant itself, so
+// they're not tracked separately.
+func parseBlameTime(s string) time.Time {
+	sec, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0)
+}
@@ -0,0 +1,92 @@
+package git_test
+
+import (
+	"io"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.abhg.dev/gs/internal/git"
+	"go.abhg.dev/gs/internal/text"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRepositoryBlame(t *testing.T) {
+	mockExecer := git.NewMockExecer(gomock.NewController(t))
+	repo, _ := git.NewFakeRepository(t, "", mockExecer)
+	ctx := t.Context()
+
+	mockExecer.EXPECT().
+		Start(gomock.Any()).
+		Do(func(cmd *exec.Cmd) error {
+			_, err := io.WriteString(cmd.Stdout, text.Dedent(`
+				fdf9519f48d4ffeb4380a03dc3305716ed22312a 2 2 1
+				author A
+				author-mail <a@b.com>
+				author-time 1785137991
+				author-tz +0000
+				committer A
+				committer-mail <a@b.com>
+				committer-time 1785137991
+				committer-tz +0000
+				summary second
+				previous 5ad2a3a5ab90968d2be400583fbabebfa0815fcb f.txt
+				filename f.txt
+				fdf9519f48d4ffeb4380a03dc3305716ed22312a 4 4 1
+				previous 5ad2a3a5ab90968d2be400583fbabebfa0815fcb f.txt
+				filename f.txt
+				5ad2a3a5ab90968d2be400583fbabebfa0815fcb 1 1 1
+				author A
+				author-mail <a@b.com>
+				author-time 1785130000
+				author-tz +0000
+				committer A
+				committer-mail <a@b.com>
+				committer-time 1785130000
+				committer-tz +0000
+				summary first
+				boundary
+				filename f.txt
+				5ad2a3a5ab90968d2be400583fbabebfa0815fcb 3 3 1
+				filename f.txt
+			`))
+			assert.NoError(t, err)
+			assert.NoError(t, cmd.Stdout.(io.Closer).Close())
+			return nil
+		})
+	mockExecer.EXPECT().
+		Wait(gomock.Any()).
+		Return(nil)
+
+	got, err := repo.Blame(ctx, git.BlameRequest{Path: "f.txt"})
+	require.NoError(t, err)
+	require.Len(t, got.Hunks, 4)
+
+	second := &git.CommitMeta{
+		Hash:      "fdf9519f48d4ffeb4380a03dc3305716ed22312a",
+		Author:    git.Signature{Name: "A", Email: "a@b.com", Time: time.Unix(1785137991, 0)},
+		Committer: git.Signature{Name: "A", Email: "a@b.com", Time: time.Unix(1785137991, 0)},
+		Summary:   "second",
+	}
+	first := &git.CommitMeta{
+		Hash:      "5ad2a3a5ab90968d2be400583fbabebfa0815fcb",
+		Author:    git.Signature{Name: "A", Email: "a@b.com", Time: time.Unix(1785130000, 0)},
+		Committer: git.Signature{Name: "A", Email: "a@b.com", Time: time.Unix(1785130000, 0)},
+		Summary:   "first",
+		Boundary:  true,
+	}
+
+	assert.Equal(t, []git.BlameHunk{
+		{Commit: second, OrigLine: 2, FinalLine: 2, LineCount: 1},
+		{Commit: second, OrigLine: 4, FinalLine: 4, LineCount: 1},
+		{Commit: first, OrigLine: 1, FinalLine: 1, LineCount: 1},
+		{Commit: first, OrigLine: 3, FinalLine: 3, LineCount: 1},
+	}, got.Hunks)
+
+	// The second hunk for each commit reused the cached CommitMeta
+	// rather than a freshly parsed (but incomplete) one.
+	assert.Same(t, got.Hunks[0].Commit, got.Hunks[1].Commit)
+	assert.Same(t, got.Hunks[2].Commit, got.Hunks[3].Commit)
+}
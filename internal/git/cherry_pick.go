@@ -1,11 +1,32 @@
 package git
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"os"
 	"os/exec"
+	"strconv"
+	"strings"
+
+	"go.abhg.dev/gs/internal/scanutil"
+)
+
+// CherryPickInterruptKind specifies the kind of cherry-pick interruption.
+type CherryPickInterruptKind int
+
+const (
+	// CherryPickInterruptConflict indicates that a cherry-pick
+	// was interrupted because applying a commit produced conflicts.
+	CherryPickInterruptConflict CherryPickInterruptKind = iota
+
+	// CherryPickInterruptEmpty indicates that a cherry-pick
+	// was interrupted because the commit's changes
+	// were already present in the current HEAD,
+	// and CherryPickEmptyStop was in effect.
+	CherryPickInterruptEmpty
 )
 
 // CherryPickInterruptedError indicates that a cherry-pick
@@ -17,18 +38,80 @@ type CherryPickInterruptedError struct {
 	// Commit is the hash of the commit that could not be applied.
 	Commit Hash
 
+	// Kind is the reason the cherry-pick was interrupted.
+	Kind CherryPickInterruptKind
+
+	// Conflicts lists the files left conflicted by the cherry-pick.
+	// It is empty unless Kind is [CherryPickInterruptConflict].
+	Conflicts []Conflict
+
 	// Err is the original error that was reported.
 	Err error
 }
 
 func (e *CherryPickInterruptedError) Error() string {
-	return fmt.Sprintf("cherry-pick %v interrupted", e.Commit)
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "cherry-pick %v interrupted", e.Commit)
+	switch e.Kind {
+	case CherryPickInterruptConflict:
+		if len(e.Conflicts) > 0 {
+			paths := make([]string, len(e.Conflicts))
+			for i, c := range e.Conflicts {
+				paths[i] = c.Path
+			}
+			fmt.Fprintf(&msg, ": %d file(s) conflict: %s", len(paths), strings.Join(paths, ", "))
+		}
+	case CherryPickInterruptEmpty:
+		msg.WriteString(": commit is empty; changes already applied")
+	}
+	return msg.String()
 }
 
 func (e *CherryPickInterruptedError) Unwrap() error {
 	return e.Err
 }
 
+// ConflictKind categorizes how a file ended up conflicted
+// during a cherry-pick or merge.
+type ConflictKind int
+
+const (
+	// ConflictBothModified indicates that the file was modified
+	// differently on both sides.
+	ConflictBothModified ConflictKind = iota
+
+	// ConflictAddAdd indicates that the file was added
+	// independently on both sides, with different contents.
+	ConflictAddAdd
+
+	// ConflictDeleteModify indicates that the file was deleted
+	// on our side, and modified on the other side.
+	ConflictDeleteModify
+
+	// ConflictModifyDelete indicates that the file was modified
+	// on our side, and deleted on the other side.
+	ConflictModifyDelete
+)
+
+// Conflict describes a single file left in a conflicted state
+// by an interrupted cherry-pick or merge.
+type Conflict struct {
+	// Path is the path of the conflicted file,
+	// relative to the repository root.
+	Path string
+
+	// Ours is the blob hash of our side of the conflict.
+	// It is empty if the file does not exist on our side.
+	Ours Hash
+
+	// Theirs is the blob hash of the other side of the conflict.
+	// It is empty if the file does not exist on the other side.
+	Theirs Hash
+
+	// Kind categorizes the conflict.
+	Kind ConflictKind
+}
+
 // CherryPickEmpty specifies how to handle cherry-picked commits
 // that would result in no changes to the current HEAD.
 type CherryPickEmpty int
@@ -136,7 +219,7 @@ func (r *Repository) CherryPickAbort(ctx context.Context) error {
 }
 
 func (r *Repository) handleCherryPickError(ctx context.Context, name string, err error) error {
-	if err != nil {
+	if err == nil {
 		return nil
 	}
 
@@ -157,8 +240,116 @@ func (r *Repository) handleCherryPickError(ctx context.Context, name string, err
 		)
 	}
 
+	conflicts, err := r.conflicts(ctx)
+	if err != nil {
+		r.log.Debug("Failed to list conflicted files", "error", err)
+	}
+
+	kind := CherryPickInterruptConflict
+	if len(conflicts) == 0 {
+		// No unmerged paths: the index is clean, so the cherry-pick
+		// must have stopped because the commit's changes were
+		// already present in the tree.
+		kind = CherryPickInterruptEmpty
+	}
+
 	return &CherryPickInterruptedError{
-		Commit: commit,
-		Err:    origErr,
+		Commit:    commit,
+		Kind:      kind,
+		Conflicts: conflicts,
+		Err:       origErr,
+	}
+}
+
+// conflicts reports the files left in a conflicted (unmerged) state
+// in the index, e.g. after a cherry-pick or merge stops partway through.
+func (r *Repository) conflicts(ctx context.Context) ([]Conflict, error) {
+	out, err := r.gitCmd(ctx, "ls-files", "--unmerged", "-z").Output(r.exec)
+	if err != nil {
+		return nil, fmt.Errorf("ls-files: %w", err)
+	}
+
+	return parseUnmergedFiles(out)
+}
+
+// parseUnmergedFiles parses the output of `git ls-files --unmerged -z`,
+// grouping the stage 1 (base), 2 (ours), and 3 (theirs) entries
+// reported for each conflicted path into a single [Conflict].
+//
+// Each record has the form:
+//
+//	<mode> <object> <stage>\t<path>\x00
+func parseUnmergedFiles(data []byte) ([]Conflict, error) {
+	var conflicts []Conflict
+	haveBase := make(map[string]bool)
+	byPath := make(map[string]int) // path -> index into conflicts
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Split(scanutil.SplitNull)
+	for scanner.Scan() {
+		hash, stage, path, ok := parseUnmergedRecord(scanner.Text())
+		if !ok {
+			continue
+		}
+
+		i, ok := byPath[path]
+		if !ok {
+			i = len(conflicts)
+			conflicts = append(conflicts, Conflict{Path: path})
+			byPath[path] = i
+		}
+
+		switch stage {
+		case 1:
+			haveBase[path] = true
+		case 2:
+			conflicts[i].Ours = Hash(hash)
+		case 3:
+			conflicts[i].Theirs = Hash(hash)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan: %w", err)
+	}
+
+	for i := range conflicts {
+		conflicts[i].Kind = conflictKind(conflicts[i], haveBase[conflicts[i].Path])
+	}
+
+	return conflicts, nil
+}
+
+// parseUnmergedRecord parses a single "<mode> <object> <stage>\t<path>" record.
+func parseUnmergedRecord(record string) (hash string, stage int, path string, ok bool) {
+	meta, path, ok := strings.Cut(record, "\t")
+	if !ok {
+		return "", 0, "", false
+	}
+
+	fields := strings.Fields(meta)
+	if len(fields) != 3 {
+		return "", 0, "", false
+	}
+
+	stage, err := strconv.Atoi(fields[2])
+	if err != nil {
+		return "", 0, "", false
+	}
+
+	return fields[1], stage, path, true
+}
+
+// conflictKind categorizes a conflict based on which of the three
+// sides of the merge have a version of the file.
+func conflictKind(c Conflict, haveBase bool) ConflictKind {
+	switch {
+	case c.Ours == "" && c.Theirs != "":
+		return ConflictDeleteModify
+	case c.Ours != "" && c.Theirs == "":
+		return ConflictModifyDelete
+	case !haveBase:
+		return ConflictAddAdd
+	default:
+		return ConflictBothModified
 	}
 }
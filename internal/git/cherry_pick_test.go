@@ -0,0 +1,37 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUnmergedFiles(t *testing.T) {
+	// Reproduces the record shape of `git ls-files --unmerged -z`
+	// for four conflicted files, one of each kind.
+	data := []byte(
+		"100644 aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa 1\tboth.txt\x00" +
+			"100644 bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb 2\tboth.txt\x00" +
+			"100644 cccccccccccccccccccccccccccccccccccccccc 3\tboth.txt\x00" +
+			"100644 dddddddddddddddddddddddddddddddddddddddd 2\tadded.txt\x00" +
+			"100644 eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee 3\tadded.txt\x00" +
+			"100644 ffffffffffffffffffffffffffffffffffffffff 1\tdeleted-by-us.txt\x00" +
+			"100644 1111111111111111111111111111111111111111 3\tdeleted-by-us.txt\x00" +
+			"100644 2222222222222222222222222222222222222222 1\tdeleted-by-them.txt\x00" +
+			"100644 3333333333333333333333333333333333333333 2\tdeleted-by-them.txt\x00",
+	)
+
+	conflicts, err := parseUnmergedFiles(data)
+	require.NoError(t, err)
+
+	byPath := make(map[string]Conflict, len(conflicts))
+	for _, c := range conflicts {
+		byPath[c.Path] = c
+	}
+
+	assert.Equal(t, ConflictBothModified, byPath["both.txt"].Kind)
+	assert.Equal(t, ConflictAddAdd, byPath["added.txt"].Kind)
+	assert.Equal(t, ConflictDeleteModify, byPath["deleted-by-us.txt"].Kind)
+	assert.Equal(t, ConflictModifyDelete, byPath["deleted-by-them.txt"].Kind)
+}
@@ -72,6 +72,9 @@ type CommitTreeRequest struct {
 // as the state of the repository.
 //
 // It returns the hash of the new commit.
+//
+// If the repository has a [Signer] installed (see [Repository.WithSigner]),
+// the commit is signed in-process instead of relying on req.GPGSign.
 func (r *Repository) CommitTree(ctx context.Context, req CommitTreeRequest) (Hash, error) {
 	if req.Message == "" {
 		return ZeroHash, errors.New("empty commit message")
@@ -80,6 +83,10 @@ func (r *Repository) CommitTree(ctx context.Context, req CommitTreeRequest) (Has
 		req.Committer = req.Author
 	}
 
+	if r.signer != nil {
+		return r.commitTreeSigned(ctx, req)
+	}
+
 	args := make([]string, 0, 2+2*len(req.Parents))
 	args = append(args, "commit-tree")
 	for _, parent := range req.Parents {
@@ -105,6 +112,81 @@ func (r *Repository) CommitTree(ctx context.Context, req CommitTreeRequest) (Has
 	return Hash(out), nil
 }
 
+// commitTreeSigned builds a commit object in memory, signs it with
+// r.signer, and writes it with `git hash-object`, bypassing git's own
+// --gpg-sign handling entirely.
+func (r *Repository) commitTreeSigned(ctx context.Context, req CommitTreeRequest) (Hash, error) {
+	if req.Author == nil {
+		req.Author = &Signature{}
+	}
+	if req.Committer == nil {
+		req.Committer = req.Author
+	}
+
+	var unsigned strings.Builder
+	writeCommitHeaders(&unsigned, req)
+	unsigned.WriteString("\n")
+	unsigned.WriteString(req.Message)
+	if !strings.HasSuffix(req.Message, "\n") {
+		unsigned.WriteString("\n")
+	}
+
+	sig, _, _, err := r.signer.Sign(ctx, []byte(unsigned.String()))
+	if err != nil {
+		return ZeroHash, fmt.Errorf("sign commit: %w", err)
+	}
+
+	var body strings.Builder
+	writeCommitHeaders(&body, req)
+	fmt.Fprintf(&body, "gpgsig %s\n", indentGPGSig(sig))
+	body.WriteString("\n")
+	body.WriteString(req.Message)
+	if !strings.HasSuffix(req.Message, "\n") {
+		body.WriteString("\n")
+	}
+
+	out, err := r.gitCmd(ctx, "hash-object", "-w", "-t", "commit", "--stdin").
+		StdinString(body.String()).
+		OutputString(r.exec)
+	if err != nil {
+		return ZeroHash, fmt.Errorf("hash-object: %w", err)
+	}
+
+	return Hash(out), nil
+}
+
+// writeCommitHeaders writes the tree, parent, author, and committer
+// header lines of a commit object (everything but the optional gpgsig
+// header and the message).
+func writeCommitHeaders(w *strings.Builder, req CommitTreeRequest) {
+	fmt.Fprintf(w, "tree %s\n", req.Tree)
+	for _, parent := range req.Parents {
+		fmt.Fprintf(w, "parent %s\n", parent)
+	}
+	fmt.Fprintf(w, "author %s\n", formatSignatureLine(req.Author))
+	fmt.Fprintf(w, "committer %s\n", formatSignatureLine(req.Committer))
+}
+
+// formatSignatureLine renders a Signature as it appears in a commit
+// object's author/committer header, e.g.:
+//
+//	Jane Doe <jane@example.com> 1700000000 -0700
+func formatSignatureLine(s *Signature) string {
+	t := s.Time
+	if t.IsZero() {
+		t = time.Now()
+	}
+	return fmt.Sprintf("%s <%s> %d %s", s.Name, s.Email, t.Unix(), t.Format("-0700"))
+}
+
+// indentGPGSig formats a signature for embedding as a commit object's
+// gpgsig header value, whose continuation lines must each start with a
+// single space.
+func indentGPGSig(sig []byte) string {
+	lines := strings.Split(strings.TrimRight(string(sig), "\n"), "\n")
+	return strings.Join(lines, "\n ")
+}
+
 // CommitObject is a Git commit object.
 type CommitObject struct {
 	Hash    Hash
@@ -1,6 +1,9 @@
 package git_test
 
 import (
+	"context"
+	"io"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -491,6 +494,71 @@ func TestRepository_ReadCommit_integration(t *testing.T) {
 	})
 }
 
+// fakeSigner is a [git.Signer] that returns a canned signature,
+// recording the data it was asked to sign.
+type fakeSigner struct {
+	gotData []byte
+}
+
+var _ git.Signer = (*fakeSigner)(nil)
+
+func (s *fakeSigner) Sign(_ context.Context, data []byte) (sig []byte, keyID, format string, err error) {
+	s.gotData = data
+	return []byte("-----BEGIN PGP SIGNATURE-----\nline1\nline2\n-----END PGP SIGNATURE-----"),
+		"ABCD1234", "openpgp", nil
+}
+
+func TestRepository_CommitTree_signed(t *testing.T) {
+	mockExecer := git.NewMockExecer(gomock.NewController(t))
+	repo, _ := git.NewFakeRepository(t, "", mockExecer)
+
+	signer := &fakeSigner{}
+	repo = repo.WithSigner(signer)
+
+	var gotStdin string
+	mockExecer.EXPECT().
+		Output(gomock.Any()).
+		DoAndReturn(func(cmd *exec.Cmd) ([]byte, error) {
+			assert.Equal(t, []string{"hash-object", "-w", "-t", "commit", "--stdin"}, cmd.Args[1:])
+
+			b, err := io.ReadAll(cmd.Stdin)
+			require.NoError(t, err)
+			gotStdin = string(b)
+
+			return []byte("deadbeef01234567890123456789012345678901\n"), nil
+		})
+
+	hash, err := repo.CommitTree(t.Context(), git.CommitTreeRequest{
+		Tree:    "tree1234567890123456789012345678901234567",
+		Message: "Add feature",
+		Parents: []git.Hash{"parent123456789012345678901234567890123456"},
+		Author: &git.Signature{
+			Name:  "Test Author",
+			Email: "test@author.com",
+			Time:  time.Date(2025, 6, 20, 21, 28, 29, 0, time.UTC),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, git.Hash("deadbeef01234567890123456789012345678901"), hash)
+
+	assert.Equal(t, text.Dedent(`
+		tree tree1234567890123456789012345678901234567
+		parent parent123456789012345678901234567890123456
+		author Test Author <test@author.com> 1750454909 +0000
+		committer Test Author <test@author.com> 1750454909 +0000
+		gpgsig -----BEGIN PGP SIGNATURE-----
+		 line1
+		 line2
+		 -----END PGP SIGNATURE-----
+
+		Add feature
+	`), gotStdin)
+
+	// The signed data excludes the gpgsig header itself.
+	assert.NotContains(t, string(signer.gotData), "gpgsig")
+	assert.Contains(t, string(signer.gotData), "Add feature")
+}
+
 // joinNull joins strings with null bytes for testing git log output parsing.
 func joinNull(parts ...string) string {
 	return strings.Join(parts, "\x00")
@@ -50,6 +50,10 @@ type CommitRequest struct {
 	// Signoff adds a Signed-off-by trailer to the commit message.
 	Signoff bool
 
+	// Sign requests that the commit be cryptographically signed,
+	// using whatever signing key is configured for the repository.
+	Sign bool
+
 	// If set, the Author and/or Committer signatures are used for the commit.
 	Author, Committer *Signature
 }
@@ -102,6 +106,9 @@ func (w *Worktree) Commit(ctx context.Context, req CommitRequest) error {
 	if req.Signoff {
 		args = append(args, "--signoff")
 	}
+	if req.Sign {
+		args = append(args, "-S")
+	}
 
 	cmd := w.gitCmd(ctx, args...).
 		WithStdin(os.Stdin).
@@ -70,3 +70,35 @@ func (r *Repository) DiffIndex(ctx context.Context, treeish string) ([]FileStatu
 
 	return files, nil
 }
+
+// DiffNameOnly reports the paths of files that differ between two
+// commit-ish revisions, from and to.
+func (r *Repository) DiffNameOnly(ctx context.Context, from, to string) ([]string, error) {
+	cmd := r.gitCmd(ctx, "diff", "--name-only", from, to)
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("pipe: %w", err)
+	}
+
+	if err := cmd.Start(r.exec); err != nil {
+		return nil, fmt.Errorf("start: %w", err)
+	}
+
+	var paths []string
+	scanner := bufio.NewScanner(out)
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" {
+			paths = append(paths, line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan: %w", err)
+	}
+
+	if err := cmd.Wait(r.exec); err != nil {
+		return nil, fmt.Errorf("diff: %w", err)
+	}
+
+	return paths, nil
+}
@@ -20,6 +20,11 @@ type FetchOptions struct {
 	// Refspecs are the refspecs to fetch.
 	// If non-empty, the Remote must be specified as well.
 	Refspecs []Refspec
+
+	// Filter restricts the set of objects downloaded by the fetch,
+	// for partial clones of very large repositories.
+	// If nil, all objects referenced by the fetched refs are downloaded.
+	Filter *CloneFilter
 }
 
 // Fetch fetches objects and refs from a remote repository.
@@ -31,6 +36,7 @@ func (r *Repository) Fetch(ctx context.Context, opts FetchOptions) error {
 	r.log.Debug("Fetching from remote", silog.NonZero("name", opts.Remote))
 
 	args := []string{"fetch"}
+	args = append(args, opts.Filter.args()...)
 	if opts.Remote != "" {
 		args = append(args, opts.Remote)
 	}
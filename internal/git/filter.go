@@ -0,0 +1,53 @@
+package git
+
+import "fmt"
+
+// CloneFilter specifies a partial clone filter,
+// rendered as a `--filter=<spec>` flag for commands that support it.
+//
+// Construct one with [BlobNone], [BlobLimit], [TreeDepth], or [SparseOID].
+type CloneFilter struct {
+	spec string
+}
+
+// BlobNone excludes all blobs.
+// Blobs are fetched on demand as they're accessed,
+// which is the usual choice for cloning very large repositories.
+func BlobNone() *CloneFilter {
+	return &CloneFilter{spec: "blob:none"}
+}
+
+// BlobLimit excludes blobs larger than the given number of bytes.
+func BlobLimit(bytes int64) *CloneFilter {
+	return &CloneFilter{spec: fmt.Sprintf("blob:limit=%d", bytes)}
+}
+
+// TreeDepth excludes trees and blobs beyond the given depth from the root tree.
+// A depth of 0 is equivalent to [BlobNone].
+func TreeDepth(depth int) *CloneFilter {
+	return &CloneFilter{spec: fmt.Sprintf("tree:%d", depth)}
+}
+
+// SparseOID limits the result to the objects reachable from the paths
+// named in the sparse-checkout specification stored in the blob at oid.
+func SparseOID(oid Hash) *CloneFilter {
+	return &CloneFilter{spec: "sparse:oid=" + oid.String()}
+}
+
+// String returns the filter-spec, e.g. "blob:none", without the
+// "--filter=" prefix.
+func (f *CloneFilter) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.spec
+}
+
+// args returns the command-line arguments for this filter,
+// or nil if f is nil.
+func (f *CloneFilter) args() []string {
+	if f == nil {
+		return nil
+	}
+	return []string{"--filter=" + f.spec}
+}
@@ -0,0 +1,44 @@
+package git_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.abhg.dev/gs/internal/git"
+)
+
+func TestCloneFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter *git.CloneFilter
+		want   string
+	}{
+		{name: "Nil"},
+		{
+			name:   "BlobNone",
+			filter: git.BlobNone(),
+			want:   "blob:none",
+		},
+		{
+			name:   "BlobLimit",
+			filter: git.BlobLimit(1024),
+			want:   "blob:limit=1024",
+		},
+		{
+			name:   "TreeDepth",
+			filter: git.TreeDepth(0),
+			want:   "tree:0",
+		},
+		{
+			name:   "SparseOID",
+			filter: git.SparseOID("abc123"),
+			want:   "sparse:oid=abc123",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.filter.String())
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package git
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"os/exec"
+	"strconv"
+)
+
+// GrepRequest configures a Grep search.
+type GrepRequest struct {
+	// Pattern is the regular expression to search for.
+	Pattern string
+
+	// Revision limits the search to a single revision.
+	// If empty, the working tree is searched.
+	Revision string
+
+	// Pathspecs restricts the search to paths matching any of these
+	// pathspecs. If empty, the whole tree is searched.
+	Pathspecs []string
+
+	// ExtendedRegexp interprets Pattern as a POSIX extended regular
+	// expression (-E) instead of a basic one. Ignored if PerlRegexp
+	// is set.
+	ExtendedRegexp bool
+
+	// PerlRegexp interprets Pattern as a Perl-compatible regular
+	// expression (-P).
+	PerlRegexp bool
+
+	// IgnoreCase makes the search case-insensitive.
+	IgnoreCase bool
+
+	// Cached searches the index instead of the working tree.
+	// Has no effect if Revision is set.
+	Cached bool
+
+	// Untracked additionally searches untracked files.
+	// Has no effect if Revision is set.
+	Untracked bool
+}
+
+// GrepMatch is a single line matched by [Repository.Grep].
+type GrepMatch struct {
+	// Path is the path of the file containing the match,
+	// relative to the repository root.
+	Path string
+
+	// Line is the 1-indexed line number of the match.
+	Line int
+
+	// Column is the 1-indexed byte offset of the start of the match
+	// within the line.
+	Column int
+
+	// Content is the full contents of the matching line.
+	Content string
+}
+
+// Grep searches tracked (and, optionally, untracked) files for lines
+// matching req.Pattern, streaming matches as `git grep` reports them.
+//
+// It wraps:
+//
+//	git grep --null --line-number --column --full-name
+//
+// --null keeps the output binary-safe by NUL-terminating the file name
+// field instead of using ':', which would otherwise be ambiguous for
+// paths containing colons. Like [Repository.ListRemoteRefs], lines that
+// don't parse as a well-formed record are logged and skipped rather
+// than failing the whole search.
+func (r *Repository) Grep(ctx context.Context, req GrepRequest) iter.Seq2[GrepMatch, error] {
+	args := []string{"grep", "--null", "--line-number", "--column", "--full-name"}
+	if req.IgnoreCase {
+		args = append(args, "--ignore-case")
+	}
+	if req.PerlRegexp {
+		args = append(args, "--perl-regexp")
+	} else if req.ExtendedRegexp {
+		args = append(args, "--extended-regexp")
+	}
+	if req.Revision == "" {
+		if req.Cached {
+			args = append(args, "--cached")
+		}
+		if req.Untracked {
+			args = append(args, "--untracked")
+		}
+	}
+	args = append(args, "-e", req.Pattern)
+	if req.Revision != "" {
+		args = append(args, req.Revision)
+	}
+	if len(req.Pathspecs) > 0 {
+		args = append(args, "--")
+		args = append(args, req.Pathspecs...)
+	}
+
+	return func(yield func(GrepMatch, error) bool) {
+		cmd := r.gitCmd(ctx, args...)
+		out, err := cmd.StdoutPipe()
+		if err != nil {
+			yield(GrepMatch{}, fmt.Errorf("pipe stdout: %w", err))
+			return
+		}
+
+		if err := cmd.Start(r.exec); err != nil {
+			yield(GrepMatch{}, fmt.Errorf("start: %w", err))
+			return
+		}
+		var finished bool
+		defer func() {
+			if !finished {
+				_ = cmd.Kill(r.exec)
+			}
+		}()
+
+		reader := bufio.NewReaderSize(out, 4096)
+		for {
+			match, err := parseGrepMatch(reader)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				r.log.Warnf("grep: skipping malformed record: %v", err)
+				continue
+			}
+
+			if !yield(match, nil) {
+				return
+			}
+		}
+
+		if err := cmd.Wait(r.exec); err != nil {
+			// git grep exits with status 1 (and no stderr output)
+			// when the search completes without finding a match.
+			// That's not a failure.
+			var exitErr *exec.ExitError
+			if !errors.As(err, &exitErr) || exitErr.ExitCode() != 1 {
+				yield(GrepMatch{}, fmt.Errorf("git grep: %w", err))
+				return
+			}
+		}
+
+		finished = true
+	}
+}
+
+// parseGrepMatch reads a single "git grep --null" record from r:
+//
+//	<path>\0<line>\0<col>\0<content>\n
+//
+// Unlike the path, line, and column fields, the trailing content field
+// is newline- rather than NUL-terminated, so it can't be read with a
+// single NUL-delimited scan; read it as its own token instead.
+//
+// It returns io.EOF, unwrapped, once r is exhausted between records.
+func parseGrepMatch(r *bufio.Reader) (GrepMatch, error) {
+	path, err := readToken(r, 0)
+	if err != nil {
+		return GrepMatch{}, err // may be io.EOF
+	}
+	lineStr, err := readToken(r, 0)
+	if err != nil {
+		return GrepMatch{}, fmt.Errorf("read line number for %q: %w", path, err)
+	}
+	colStr, err := readToken(r, 0)
+	if err != nil {
+		return GrepMatch{}, fmt.Errorf("read column for %q: %w", path, err)
+	}
+	content, err := readToken(r, '\n')
+	if err != nil {
+		return GrepMatch{}, fmt.Errorf("read content for %q: %w", path, err)
+	}
+
+	line, err := strconv.Atoi(lineStr)
+	if err != nil {
+		return GrepMatch{}, fmt.Errorf("parse line number %q: %w", lineStr, err)
+	}
+	col, err := strconv.Atoi(colStr)
+	if err != nil {
+		return GrepMatch{}, fmt.Errorf("parse column %q: %w", colStr, err)
+	}
+
+	return GrepMatch{
+		Path:    path,
+		Line:    line,
+		Column:  col,
+		Content: content,
+	}, nil
+}
+
+// readToken reads bytes up to and including delim, returning them with
+// the delimiter stripped. It reports io.EOF, unwrapped, only when no
+// bytes at all were read, so callers can tell a clean end of input
+// (between records) apart from a record truncated partway through.
+func readToken(r *bufio.Reader, delim byte) (string, error) {
+	s, err := r.ReadString(delim)
+	if err != nil {
+		if errors.Is(err, io.EOF) && s == "" {
+			return "", io.EOF
+		}
+		return "", fmt.Errorf("unexpected end of input: %w", err)
+	}
+	return s[:len(s)-1], nil
+}
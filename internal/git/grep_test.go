@@ -0,0 +1,80 @@
+package git_test
+
+import (
+	"io"
+	"os/exec"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.abhg.dev/gs/internal/git"
+	"go.abhg.dev/gs/internal/sliceutil"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRepositoryGrep(t *testing.T) {
+	mockExecer := git.NewMockExecer(gomock.NewController(t))
+	repo, _ := git.NewFakeRepository(t, "", mockExecer)
+	ctx := t.Context()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	mockExecer.EXPECT().
+		Start(gomock.Any()).
+		Do(func(cmd *exec.Cmd) error {
+			wg.Go(func() {
+				_, _ = io.WriteString(cmd.Stdout, "foo.go\x0012\x005\x00func Foo() {}\n")
+				_, _ = io.WriteString(cmd.Stdout, "malformed.go\x00NaN\x001\x00ignored\n")
+				_, _ = io.WriteString(cmd.Stdout, "bar.go\x003\x001\x00Foo()\n")
+				assert.NoError(t, cmd.Stdout.(io.Closer).Close())
+			})
+			return nil
+		})
+	mockExecer.EXPECT().
+		Wait(gomock.Any()).
+		Return(nil)
+
+	got, err := sliceutil.CollectErr(repo.Grep(ctx, git.GrepRequest{Pattern: "Foo"}))
+	require.NoError(t, err)
+
+	assert.Equal(t, []git.GrepMatch{
+		{Path: "foo.go", Line: 12, Column: 5, Content: "func Foo() {}"},
+		{Path: "bar.go", Line: 3, Column: 1, Content: "Foo()"},
+	}, got)
+}
+
+func TestRepositoryGrepOptions(t *testing.T) {
+	mockExecer := git.NewMockExecer(gomock.NewController(t))
+	repo, _ := git.NewFakeRepository(t, "", mockExecer)
+	ctx := t.Context()
+
+	mockExecer.EXPECT().
+		Start(gomock.Any()).
+		Do(func(cmd *exec.Cmd) error {
+			assert.Equal(t, []string{
+				"grep", "--null", "--line-number", "--column", "--full-name",
+				"--ignore-case", "--extended-regexp",
+				"-e", "Fo+",
+				"main", "--", "*.go",
+			}, cmd.Args[1:])
+
+			return cmd.Stdout.(io.Closer).Close()
+		})
+	mockExecer.EXPECT().
+		Wait(gomock.Any()).
+		Return(nil)
+
+	req := git.GrepRequest{
+		Pattern:        "Fo+",
+		Revision:       "main",
+		Pathspecs:      []string{"*.go"},
+		ExtendedRegexp: true,
+		IgnoreCase:     true,
+	}
+
+	got, err := sliceutil.CollectErr(repo.Grep(ctx, req))
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
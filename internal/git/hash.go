@@ -5,18 +5,81 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 )
 
 // ErrNotExist is returned when a Git object does not exist.
 var ErrNotExist = errors.New("does not exist")
 
-// Hash is a 40-character Git object ID.
+// Hash is a Git object ID, hex-encoded.
+//
+// Its length depends on the repository's hash algorithm:
+// 40 characters for SHA-1, or 64 for SHA-256.
+// See [HashAlgo] and [Repository.HashAlgorithm].
 type Hash string
 
-// ZeroHash is the hash of an empty Git object.
+// ZeroHash is the all-zeroes hash in its SHA-1 form.
 // It is used to represent the absence of a hash.
+//
+// SHA-256 repositories have a longer all-zeroes hash;
+// use [Repository.ZeroHash] when the repository's hash algorithm
+// is not known to be SHA-1.
 const ZeroHash Hash = "0000000000000000000000000000000000000000"
 
+// HashAlgo identifies the hash algorithm a repository uses for its
+// object IDs.
+type HashAlgo string
+
+const (
+	// SHA1 is Git's original, and still default, hash algorithm.
+	SHA1 HashAlgo = "sha1"
+
+	// SHA256 is the hash algorithm used by repositories initialized
+	// with `git init --object-format=sha256`.
+	SHA256 HashAlgo = "sha256"
+)
+
+// Size reports the number of raw bytes in a hash produced by this
+// algorithm: 20 for SHA-1, 32 for SHA-256.
+func (a HashAlgo) Size() int {
+	if a == SHA256 {
+		return 32
+	}
+	return 20
+}
+
+// HexSize reports the number of hex characters in the string form of a
+// hash produced by this algorithm.
+func (a HashAlgo) HexSize() int {
+	return a.Size() * 2
+}
+
+// zeroHash reports the all-zeroes hash for this algorithm.
+func (a HashAlgo) zeroHash() Hash {
+	return Hash(strings.Repeat("0", a.HexSize()))
+}
+
+// HashAlgorithm reports the hash algorithm used by the repository's
+// objects, as detected when the repository was opened.
+//
+// If the algorithm could not be detected (e.g. because the installed
+// Git predates --object-format support), SHA1 is assumed.
+func (r *Repository) HashAlgorithm() HashAlgo {
+	if r.hashAlgo == "" {
+		return SHA1
+	}
+	return r.hashAlgo
+}
+
+// ZeroHash reports the all-zeroes hash for the repository's object
+// format.
+//
+// Unlike the package-level [ZeroHash] constant, which is always the
+// SHA-1 form, this is also correct for SHA-256 repositories.
+func (r *Repository) ZeroHash() Hash {
+	return r.HashAlgorithm().zeroHash()
+}
+
 func (h Hash) String() string {
 	return string(h)
 }
@@ -0,0 +1,56 @@
+package git_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.abhg.dev/gs/internal/git"
+	"go.abhg.dev/gs/internal/silog/silogtest"
+)
+
+func TestIntegrationInit_sha256(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	repo, err := git.Init(ctx, t.TempDir(), git.InitOptions{
+		Log:          silogtest.New(t),
+		ObjectFormat: git.SHA256,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, git.SHA256, repo.HashAlgorithm())
+	assert.Len(t, repo.ZeroHash(), 64)
+
+	emptyFile, err := repo.WriteObject(ctx, git.BlobType, bytes.NewReader(nil))
+	require.NoError(t, err)
+	assert.Len(t, emptyFile, 64, "sha256 blob hash should be 64 hex characters")
+
+	dirHash, err := repo.MakeTree(ctx, []git.TreeEntry{
+		{Type: git.BlobType, Name: "foo", Hash: emptyFile},
+	})
+	require.NoError(t, err)
+	assert.Len(t, dirHash, 64)
+
+	ents, err := repo.ListTree(ctx, dirHash, git.ListTreeOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []git.TreeEntry{
+		{Mode: git.RegularMode, Type: git.BlobType, Name: "foo", Hash: emptyFile},
+	}, ents)
+
+	newHash, err := repo.UpdateTree(ctx, git.UpdateTreeRequest{
+		Tree: dirHash,
+		Writes: []git.BlobInfo{
+			{Path: "bar/baz", Hash: emptyFile},
+		},
+	})
+	require.NoError(t, err)
+
+	ents, err = repo.ListTree(ctx, newHash, git.ListTreeOptions{Recurse: true})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []git.TreeEntry{
+		{Mode: git.RegularMode, Type: git.BlobType, Name: "foo", Hash: emptyFile},
+		{Mode: git.RegularMode, Type: git.BlobType, Name: "bar/baz", Hash: emptyFile},
+	}, ents)
+}
@@ -1,6 +1,7 @@
 package git
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -28,3 +29,35 @@ func TestHashShort(t *testing.T) {
 		})
 	}
 }
+
+func TestHashAlgo(t *testing.T) {
+	tests := []struct {
+		algo     HashAlgo
+		size     int
+		hexSize  int
+		wantZero Hash
+	}{
+		{SHA1, 20, 40, ZeroHash},
+		{SHA256, 32, 64, Hash(strings.Repeat("0", 64))},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.algo), func(t *testing.T) {
+			assert.Equal(t, tt.size, tt.algo.Size())
+			assert.Equal(t, tt.hexSize, tt.algo.HexSize())
+			assert.Equal(t, tt.wantZero, tt.algo.zeroHash())
+		})
+	}
+}
+
+func TestRepositoryHashAlgorithm_default(t *testing.T) {
+	r := &Repository{}
+	assert.Equal(t, SHA1, r.HashAlgorithm())
+	assert.Equal(t, ZeroHash, r.ZeroHash())
+}
+
+func TestRepositoryHashAlgorithm_sha256(t *testing.T) {
+	r := &Repository{hashAlgo: SHA256}
+	assert.Equal(t, SHA256, r.HashAlgorithm())
+	assert.Len(t, r.ZeroHash(), 64)
+}
@@ -0,0 +1,237 @@
+package git
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ObjectInfo reports the type and size of a Git object,
+// as reported by `git cat-file --batch-check`.
+type ObjectInfo struct {
+	// Hash is the hash of the object, as echoed back by cat-file.
+	Hash Hash
+
+	// Type is the type of the object (blob, tree, commit, or tag).
+	Type Type
+
+	// Size is the size of the object's payload in bytes.
+	Size int64
+}
+
+// ObjectReader is a long-lived reader of Git objects,
+// backed by persistent `git cat-file --batch` and `--batch-check`
+// subprocesses.
+//
+// Unlike one-off reads, an ObjectReader amortizes subprocess startup cost
+// across many reads, which matters for operations that touch a large
+// number of blobs and trees, such as recursive tree walks.
+//
+// An ObjectReader is safe for concurrent use:
+// requests are serialized onto the underlying subprocesses internally.
+// It must be closed with [ObjectReader.Close] once no longer needed.
+type ObjectReader struct {
+	batch      *batchProc // full object contents
+	batchCheck *batchProc // metadata only
+	hashAlgo   HashAlgo
+}
+
+// OpenObjectReader starts a long-lived `git cat-file` reader
+// for the repository, backed by persistent `--batch` and `--batch-check`
+// subprocesses.
+//
+// The returned ObjectReader must be closed with [ObjectReader.Close]
+// once the caller is done with it.
+func (r *Repository) OpenObjectReader(ctx context.Context) (*ObjectReader, error) {
+	batch, err := newBatchProc(ctx, r, "--batch")
+	if err != nil {
+		return nil, fmt.Errorf("start cat-file --batch: %w", err)
+	}
+
+	batchCheck, err := newBatchProc(ctx, r, "--batch-check")
+	if err != nil {
+		_ = batch.Close()
+		return nil, fmt.Errorf("start cat-file --batch-check: %w", err)
+	}
+
+	return &ObjectReader{
+		batch:      batch,
+		batchCheck: batchCheck,
+		hashAlgo:   r.HashAlgorithm(),
+	}, nil
+}
+
+// Close shuts down the underlying subprocesses.
+func (or *ObjectReader) Close() error {
+	err := or.batch.Close()
+	if checkErr := or.batchCheck.Close(); err == nil {
+		err = checkErr
+	}
+	return err
+}
+
+// Info reports the type and size of the object with the given hash,
+// without reading its contents.
+//
+// It returns [ErrNotExist] if the object does not exist.
+func (or *ObjectReader) Info(ctx context.Context, hash Hash) (ObjectInfo, error) {
+	return or.batchCheck.request(ctx, hash, nil)
+}
+
+// Read reads the contents of the object with the given hash into dst,
+// and reports its type and size.
+//
+// It returns [ErrNotExist] if the object does not exist.
+func (or *ObjectReader) Read(ctx context.Context, hash Hash, dst io.Writer) (ObjectInfo, error) {
+	return or.batch.request(ctx, hash, dst)
+}
+
+// batchProc manages a single `git cat-file --batch` or `--batch-check`
+// subprocess, serializing requests onto its stdin and stdout pipes.
+//
+// mu acts as the internal request queue: only one request may be
+// in flight on the pipe at a time, and callers block until it's their turn.
+type batchProc struct {
+	cmd   *gitCmd
+	exec  execer
+	stdin io.WriteCloser
+	out   *bufio.Reader
+
+	// checkOnly is true for a `--batch-check` process, whose replies
+	// never carry a payload, even for objects with a non-zero size.
+	checkOnly bool
+
+	mu sync.Mutex
+}
+
+func newBatchProc(ctx context.Context, r *Repository, mode string) (*batchProc, error) {
+	cmd := r.gitCmd(ctx, "cat-file", mode)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("pipe stdin: %w", err)
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("pipe stdout: %w", err)
+	}
+
+	if err := cmd.Start(r.exec); err != nil {
+		return nil, fmt.Errorf("start: %w", err)
+	}
+
+	return &batchProc{
+		cmd:       cmd,
+		exec:      r.exec,
+		stdin:     stdin,
+		out:       bufio.NewReader(stdout),
+		checkOnly: mode == "--batch-check",
+	}, nil
+}
+
+func (p *batchProc) Close() error {
+	_ = p.stdin.Close()
+	return p.cmd.Wait(p.exec)
+}
+
+// request writes hash to the subprocess and parses its reply.
+//
+// The reply is framed as either:
+//
+//	<hash> missing\n
+//
+// or:
+//
+//	<hash> <type> <size>\n
+//	<payload of size bytes>\n
+//
+// A --batch-check process (p.checkOnly) never emits the payload line,
+// regardless of size, so it's skipped entirely in that mode.
+//
+// If dst is non-nil, the payload is copied to it; the trailing newline
+// is always consumed so the pipe stays framed for the next request.
+//
+// If ctx is canceled while the payload is still being read, request stops
+// writing to dst but keeps draining the payload off the pipe, so that a
+// caller abandoning a read doesn't corrupt the framing for whoever's next
+// in the queue.
+func (p *batchProc) request(ctx context.Context, hash Hash, dst io.Writer) (ObjectInfo, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := io.WriteString(p.stdin, hash.String()+"\n"); err != nil {
+		return ObjectInfo{}, fmt.Errorf("write request: %w", err)
+	}
+
+	line, err := p.out.ReadString('\n')
+	if err != nil {
+		return ObjectInfo{}, fmt.Errorf("read header: %w", err)
+	}
+	line = strings.TrimSuffix(line, "\n")
+
+	if strings.HasSuffix(line, " missing") {
+		return ObjectInfo{}, ErrNotExist
+	}
+
+	fields := strings.SplitN(line, " ", 3)
+	if len(fields) != 3 {
+		return ObjectInfo{}, fmt.Errorf("unexpected cat-file reply: %q", line)
+	}
+
+	size, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return ObjectInfo{}, fmt.Errorf("parse size %q: %w", fields[2], err)
+	}
+
+	info := ObjectInfo{
+		Hash: Hash(fields[0]),
+		Type: Type(fields[1]),
+		Size: size,
+	}
+
+	if !p.checkOnly {
+		if info.Size > 0 {
+			if err := p.drainPayload(ctx, dst, info.Size); err != nil {
+				return ObjectInfo{}, fmt.Errorf("read payload: %w", err)
+			}
+		}
+		if _, err := p.out.Discard(1); err != nil { // trailing newline
+			return ObjectInfo{}, fmt.Errorf("read payload: %w", err)
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return ObjectInfo{}, err
+	}
+	return info, nil
+}
+
+// drainPayload copies exactly size bytes off the pipe, writing them to dst
+// until ctx is canceled or dst returns an error, after which it keeps
+// reading (and discarding) the remainder so the pipe isn't left mid-frame.
+func (p *batchProc) drainPayload(ctx context.Context, dst io.Writer, size int64) error {
+	buf := make([]byte, 32*1024)
+	for size > 0 {
+		n := int64(len(buf))
+		if size < n {
+			n = size
+		}
+
+		read, err := io.ReadFull(p.out, buf[:n])
+		size -= int64(read)
+		if err != nil {
+			return err
+		}
+
+		if dst != nil && ctx.Err() == nil {
+			if _, err := dst.Write(buf[:read]); err != nil {
+				dst = nil // keep draining the pipe, but stop writing
+			}
+		}
+	}
+	return nil
+}
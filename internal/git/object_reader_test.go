@@ -0,0 +1,82 @@
+package git_test
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"os/exec"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.abhg.dev/gs/internal/git"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRepositoryOpenObjectReader(t *testing.T) {
+	mockExecer := git.NewMockExecer(gomock.NewController(t))
+	repo, _ := git.NewFakeRepository(t, "", mockExecer)
+	ctx := t.Context()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	mockExecer.EXPECT().
+		Start(gomock.Any()).
+		Do(func(cmd *exec.Cmd) error {
+			assert.Equal(t, []string{"cat-file", "--batch"}, cmd.Args[1:])
+
+			wg.Go(func() {
+				scanner := bufio.NewScanner(cmd.Stdin)
+				for scanner.Scan() {
+					switch hash := scanner.Text(); hash {
+					case "abc123":
+						_, _ = io.WriteString(cmd.Stdout, "abc123 blob 5\nhello\n")
+					case "missing123":
+						_, _ = io.WriteString(cmd.Stdout, "missing123 missing\n")
+					}
+				}
+				assert.NoError(t, cmd.Stdout.(io.Closer).Close())
+			})
+			return nil
+		})
+	mockExecer.EXPECT().
+		Start(gomock.Any()).
+		Do(func(cmd *exec.Cmd) error {
+			assert.Equal(t, []string{"cat-file", "--batch-check"}, cmd.Args[1:])
+
+			wg.Go(func() {
+				scanner := bufio.NewScanner(cmd.Stdin)
+				for scanner.Scan() {
+					if scanner.Text() == "abc123" {
+						_, _ = io.WriteString(cmd.Stdout, "abc123 blob 5\n")
+					}
+				}
+				assert.NoError(t, cmd.Stdout.(io.Closer).Close())
+			})
+			return nil
+		})
+	mockExecer.EXPECT().
+		Wait(gomock.Any()).
+		Return(nil).
+		Times(2)
+
+	or, err := repo.OpenObjectReader(ctx)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	info, err := or.Read(ctx, "abc123", &buf)
+	require.NoError(t, err)
+	assert.Equal(t, git.ObjectInfo{Hash: "abc123", Type: git.BlobType, Size: 5}, info)
+	assert.Equal(t, "hello", buf.String())
+
+	_, err = or.Read(ctx, "missing123", io.Discard)
+	assert.ErrorIs(t, err, git.ErrNotExist)
+
+	info, err = or.Info(ctx, "abc123")
+	require.NoError(t, err)
+	assert.Equal(t, git.ObjectInfo{Hash: "abc123", Type: git.BlobType, Size: 5}, info)
+
+	require.NoError(t, or.Close())
+}
@@ -76,6 +76,11 @@ type ListRemoteRefsOptions struct {
 
 	// Patterns specifies additional filters on the reference names.
 	Patterns []string
+
+	// Filter restricts the set of objects considered for the listed refs,
+	// for partial clones of very large repositories.
+	// If nil, no filter is applied.
+	Filter *CloneFilter
 }
 
 // ListRemoteRefs lists references in a remote Git repository
@@ -91,6 +96,7 @@ func (r *Repository) ListRemoteRefs(
 	if opts.Heads {
 		args = append(args, "--heads")
 	}
+	args = append(args, opts.Filter.args()...)
 	args = append(args, remote)
 	args = append(args, opts.Patterns...)
 
@@ -64,7 +64,7 @@ func TestRepositoryListRemoteRefsOptions(t *testing.T) {
 		Do(func(cmd *exec.Cmd) error {
 			assert.Equal(t, []string{
 				"ls-remote", "--quiet",
-				"--heads", "origin", "refs/heads/feat*",
+				"--heads", "--filter=blob:none", "origin", "refs/heads/feat*",
 			}, cmd.Args[1:])
 
 			wg.Go(func() {
@@ -82,6 +82,7 @@ func TestRepositoryListRemoteRefsOptions(t *testing.T) {
 	opts := git.ListRemoteRefsOptions{
 		Heads:    true,
 		Patterns: []string{"refs/heads/feat*"},
+		Filter:   git.BlobNone(),
 	}
 
 	for ref, err := range repo.ListRemoteRefs(ctx, "origin", &opts) {
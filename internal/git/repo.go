@@ -18,6 +18,14 @@ type InitOptions struct {
 	// Defaults to "main".
 	Branch string
 
+	// ObjectFormat selects the hash algorithm used for objects in the
+	// new repository. Defaults to [SHA1] if unset.
+	//
+	// This is passed straight through to `git init --object-format`,
+	// so it needs a Git version that understands that flag;
+	// if git init rejects it, Init returns that error.
+	ObjectFormat HashAlgo
+
 	exec execer
 }
 
@@ -31,10 +39,15 @@ func Init(ctx context.Context, dir string, opts InitOptions) (*Repository, error
 		opts.Branch = "main"
 	}
 
-	initCmd := newGitCmd(ctx, opts.Log, nil, /* extra */
+	args := []string{
 		"init",
-		"--initial-branch="+opts.Branch,
-	).Dir(dir)
+		"--initial-branch=" + opts.Branch,
+	}
+	if opts.ObjectFormat != "" {
+		args = append(args, "--object-format="+string(opts.ObjectFormat))
+	}
+
+	initCmd := newGitCmd(ctx, opts.Log, nil /* extra */, args...).Dir(dir)
 	if err := initCmd.Run(opts.exec); err != nil {
 		return nil, fmt.Errorf("git init: %w", err)
 	}
@@ -77,7 +90,31 @@ func Open(ctx context.Context, dir string, opts OpenOptions) (*Repository, error
 		return nil, fmt.Errorf("unexpected output from git rev-parse: %q", out)
 	}
 
-	return newRepository(root, gitDir, opts.Log, opts.exec), nil
+	algo := detectHashAlgo(ctx, root, opts.Log, opts.exec)
+
+	repo := newRepository(root, gitDir, opts.Log, opts.exec)
+	repo.hashAlgo = algo
+	return repo, nil
+}
+
+// detectHashAlgo reports the hash algorithm used by the repository at
+// dir, by asking Git directly. If this can't be determined (e.g. the
+// installed Git predates --show-object-format), SHA1 is assumed.
+func detectHashAlgo(ctx context.Context, dir string, log *log.Logger, exec execer) HashAlgo {
+	out, err := newGitCmd(ctx, log, nil, /* extra config */
+		"rev-parse",
+		"--show-object-format",
+	).Dir(dir).OutputString(exec)
+	if err != nil {
+		return SHA1
+	}
+
+	switch strings.TrimSpace(out) {
+	case "sha256":
+		return SHA256
+	default:
+		return SHA1
+	}
 }
 
 // CloneOptions configures the behavior of [Clone].
@@ -85,6 +122,11 @@ type CloneOptions struct {
 	// Log specifies the logger to use for messages.
 	Log *log.Logger
 
+	// Filter restricts the set of objects downloaded by the clone,
+	// for partial clones of very large repositories.
+	// If nil, the clone is complete.
+	Filter *CloneFilter
+
 	exec execer
 }
 
@@ -94,12 +136,18 @@ func Clone(ctx context.Context, url, dir string, opts CloneOptions) (*Repository
 		opts.exec = _realExec
 	}
 
-	cloneCmd := newGitCmd(ctx, opts.Log, nil /* extraConfig */, "clone", url, dir)
+	args := append([]string{"clone"}, opts.Filter.args()...)
+	args = append(args, url, dir)
+
+	cloneCmd := newGitCmd(ctx, opts.Log, nil /* extraConfig */, args...)
 	if err := cloneCmd.Run(opts.exec); err != nil {
 		return nil, fmt.Errorf("git clone: %w", err)
 	}
 
-	return Open(ctx, dir, OpenOptions(opts))
+	return Open(ctx, dir, OpenOptions{
+		Log:  opts.Log,
+		exec: opts.exec,
+	})
 }
 
 // Repository is a handle to a Git repository.
@@ -111,6 +159,16 @@ type Repository struct {
 	log  *log.Logger
 	exec execer
 	cfg  extraConfig
+
+	// hashAlgo is the hash algorithm detected for this repository's
+	// objects when it was opened. Empty means SHA1.
+	// See HashAlgorithm.
+	hashAlgo HashAlgo
+
+	// signer, if set, signs commits created by CommitTree in-process
+	// instead of relying on git's own --gpg-sign support.
+	// See WithSigner.
+	signer Signer
 }
 
 func newRepository(root, gitDir string, log *log.Logger, exec execer) *Repository {
@@ -136,6 +194,21 @@ func (r *Repository) WithEditor(editor string) *Repository {
 	return &newR
 }
 
+// WithSigner returns a copy of the repository that signs commits created
+// by [Repository.CommitTree] using signer.
+//
+// Instead of shelling out to `git commit-tree --gpg-sign` (which depends
+// on gpg-agent, or an equivalent long-running key agent, being reachable),
+// the commit object is built in memory, signed with signer, and written
+// directly with `git hash-object`. This lets git-spice sign
+// restacked/rewritten commits without a per-commit agent round trip, and
+// enables signing from an in-process signer in headless CI.
+func (r *Repository) WithSigner(signer Signer) *Repository {
+	newR := *r
+	newR.signer = signer
+	return &newR
+}
+
 // SetWorktree changes the worktree that this Repository is operating in.
 func (r *Repository) SetWorktree(ctx context.Context, dir string) error {
 	other, err := Open(ctx, dir, OpenOptions{
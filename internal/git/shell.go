@@ -4,10 +4,12 @@ import (
 	"bufio"
 	"context"
 	"log"
+	"os"
 	"os/exec"
 
 	"go.abhg.dev/git-stack/internal/ioutil"
 	"go.abhg.dev/git-stack/internal/syncutil"
+	"go.abhg.dev/gs/internal/correlation"
 )
 
 type commander func(context.Context, string, ...string) *exec.Cmd
@@ -31,6 +33,12 @@ func (s *Shell) gitCmd(ctx context.Context, args ...string) (cmd *exec.Cmd, done
 	newCommand := s.commander.Get(exec.CommandContext)
 	cmd = newCommand(ctx, "git", args...)
 	cmd.Dir = s.WorkDir
+	if id, ok := correlation.FromContext(ctx); ok {
+		// Lets "git trace2" output (and any server-side logs that
+		// read it) be correlated with the gs invocation that
+		// spawned this process.
+		cmd.Env = append(os.Environ(), "GIT_TRACE2_PARENT_SID=gs/"+id)
+	}
 	cmd.Stderr, done = ioutil.LogWriter(s.Logger, "[git] ")
 	return cmd, done
 }
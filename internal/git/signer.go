@@ -0,0 +1,128 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+)
+
+// Signer produces a cryptographic signature over the to-be-signed contents
+// of a commit object, for embedding in its gpgsig header.
+//
+// See [Repository.WithSigner].
+type Signer interface {
+	// Sign signs data and returns the signature to embed in the
+	// commit's gpgsig header, along with the ID of the key used
+	// (empty if the signer does not report one) and
+	// the signature format (e.g. "openpgp", "ssh", "x509").
+	Sign(ctx context.Context, data []byte) (signature []byte, keyID, format string, err error)
+}
+
+// GPGSigner signs commits with a GPG key by shelling out to gpg.
+type GPGSigner struct {
+	// KeyID is the ID of the GPG key to sign with.
+	// If empty, gpg's configured default signing key is used.
+	KeyID string
+
+	// Program is the path to the gpg binary.
+	// Defaults to "gpg".
+	Program string
+}
+
+var _ Signer = (*GPGSigner)(nil)
+
+// Sign signs data with gpg, detached and ASCII-armored.
+// The reported key ID is s.KeyID, which is empty when gpg's default key is used.
+func (s *GPGSigner) Sign(ctx context.Context, data []byte) (sig []byte, keyID, format string, err error) {
+	program := s.Program
+	if program == "" {
+		program = "gpg"
+	}
+
+	args := []string{"--batch", "--yes", "-bsa"}
+	if s.KeyID != "" {
+		args = append(args, "--local-user", s.KeyID)
+	}
+
+	out, err := runSigner(ctx, program, args, data)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("gpg: %w", err)
+	}
+	return out, s.KeyID, "openpgp", nil
+}
+
+// SSHSigner signs commits with an SSH key by shelling out to
+// `ssh-keygen -Y sign`.
+type SSHSigner struct {
+	// KeyFile is the path to the SSH private key
+	// (or the corresponding public key) to sign with.
+	KeyFile string // required
+
+	// Program is the path to the ssh-keygen binary.
+	// Defaults to "ssh-keygen".
+	Program string
+}
+
+var _ Signer = (*SSHSigner)(nil)
+
+// Sign signs data with ssh-keygen, using the "git" signature namespace
+// that git itself uses for SSH-signed commits.
+func (s *SSHSigner) Sign(ctx context.Context, data []byte) (sig []byte, keyID, format string, err error) {
+	program := s.Program
+	if program == "" {
+		program = "ssh-keygen"
+	}
+
+	out, err := runSigner(ctx, program,
+		[]string{"-Y", "sign", "-n", "git", "-f", s.KeyFile}, data)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("ssh-keygen: %w", err)
+	}
+	return out, s.KeyFile, "ssh", nil
+}
+
+// X509Signer signs commits with an X.509 identity by shelling out to
+// gitsign (https://github.com/sigstore/gitsign) or a compatible
+// `gpg.x509.program`.
+type X509Signer struct {
+	// Program is the path to the gitsign (or compatible) binary.
+	// Defaults to "gitsign".
+	Program string
+}
+
+var _ Signer = (*X509Signer)(nil)
+
+// Sign signs data by invoking gitsign with the same flags
+// git would pass to a gpg.x509.program.
+// The reported key ID is always empty.
+func (s *X509Signer) Sign(ctx context.Context, data []byte) (sig []byte, keyID, format string, err error) {
+	program := s.Program
+	if program == "" {
+		program = "gitsign"
+	}
+
+	out, err := runSigner(ctx, program, []string{"-bsa"}, data)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("gitsign: %w", err)
+	}
+	return out, "", "x509", nil
+}
+
+// runSigner runs a signing helper program, feeding it data on stdin
+// and returning its stdout.
+func runSigner(ctx context.Context, program string, args []string, data []byte) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, program, args...)
+	cmd.Stdin = bytes.NewReader(data)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if stderr.Len() > 0 {
+			return nil, fmt.Errorf("%w: %s", err, bytes.TrimSpace(stderr.Bytes()))
+		}
+		return nil, err
+	}
+	return stdout.Bytes(), nil
+}
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -199,6 +200,76 @@ func (r *Repository) ListTree(
 	return ents, nil
 }
 
+// listTreeEntries lists the immediate entries of tree
+// by reading the raw tree object off or, a shared [ObjectReader].
+//
+// Unlike [Repository.ListTree], it never recurses into subtrees:
+// it's meant for callers (like [Repository.UpdateTree]) that already
+// walk the tree directory-by-directory and just need a cheaper way
+// to fetch each directory's current entries.
+func (r *Repository) listTreeEntries(ctx context.Context, or *ObjectReader, tree Hash) ([]TreeEntry, error) {
+	var buf bytes.Buffer
+	info, err := or.Read(ctx, tree, &buf)
+	if err != nil {
+		return nil, fmt.Errorf("read: %w", err)
+	}
+	if info.Type != TreeType {
+		return nil, fmt.Errorf("%v is a %v, not a tree", tree, info.Type)
+	}
+
+	return parseTreeObject(buf.Bytes(), or.hashAlgo.Size())
+}
+
+// parseTreeObject parses the raw contents of a Git tree object.
+//
+// A tree object is a sequence of entries of the form:
+//
+//	<mode> SP <name> NUL <hash, hashLen raw bytes>
+//
+// with no separator between entries. hashLen is 20 for SHA-1
+// repositories and 32 for SHA-256 ones; see [HashAlgo.Size].
+func parseTreeObject(data []byte, hashLen int) ([]TreeEntry, error) {
+	var ents []TreeEntry
+	for len(data) > 0 {
+		modeName, hashRest, ok := bytes.Cut(data, []byte{0})
+		if !ok {
+			return nil, fmt.Errorf("malformed tree entry: missing NUL terminator")
+		}
+
+		modeStr, name, ok := bytes.Cut(modeName, []byte{' '})
+		if !ok {
+			return nil, fmt.Errorf("malformed tree entry: missing mode separator")
+		}
+
+		mode, err := ParseMode(string(modeStr))
+		if err != nil {
+			return nil, fmt.Errorf("parse mode %q: %w", modeStr, err)
+		}
+
+		if len(hashRest) < hashLen {
+			return nil, fmt.Errorf("malformed tree entry: truncated hash")
+		}
+		hash := Hash(hex.EncodeToString(hashRest[:hashLen]))
+		data = hashRest[hashLen:]
+
+		typ := BlobType
+		switch mode {
+		case DirMode:
+			typ = TreeType
+		case 0o160000: // gitlink (submodule)
+			typ = CommitType
+		}
+
+		ents = append(ents, TreeEntry{
+			Mode: mode,
+			Type: typ,
+			Hash: hash,
+			Name: string(name),
+		})
+	}
+	return ents, nil
+}
+
 // UpdateTreeRequest is a request to update an existing Git tree.
 //
 // Unlike MakeTree, it's able to operate on paths with slashes.
@@ -236,6 +307,17 @@ func (r *Repository) UpdateTree(ctx context.Context, req UpdateTreeRequest) (_ H
 	if len(req.Writes) == 0 && len(req.Deletes) == 0 {
 		return req.Tree, nil
 	}
+
+	// A single update can touch many directories, each of which would
+	// otherwise need its own `ls-tree` subprocess just to read the
+	// directory's current entries. Reuse one `cat-file --batch` process
+	// across all of them instead.
+	or, err := r.OpenObjectReader(ctx)
+	if err != nil {
+		return ZeroHash, fmt.Errorf("open object reader: %w", err)
+	}
+	defer func() { _ = or.Close() }()
+
 	// We have a list of path updates. We need to take the following steps:
 	// 1. Group updates by directory.
 	// 2. Enumerate all intermediate directories for each update.
@@ -318,7 +400,7 @@ func (r *Repository) UpdateTree(ctx context.Context, req UpdateTreeRequest) (_ H
 
 		var entries []TreeEntry
 		if oldHash != ZeroHash {
-			entries, err = r.ListTree(ctx, oldHash, ListTreeOptions{})
+			entries, err = r.listTreeEntries(ctx, or, oldHash)
 			if err != nil {
 				return ZeroHash, fmt.Errorf("list %v (%v): %w", dir, oldHash, err)
 			}
@@ -342,7 +424,7 @@ func (r *Repository) UpdateTree(ctx context.Context, req UpdateTreeRequest) (_ H
 	// Process root directory separately.
 	var entries []TreeEntry
 	if req.Tree != ZeroHash && req.Tree != "" {
-		entries, err = r.ListTree(ctx, req.Tree, ListTreeOptions{})
+		entries, err = r.listTreeEntries(ctx, or, req.Tree)
 		if err != nil {
 			return ZeroHash, fmt.Errorf("list root (%v): %w", req.Tree, err)
 		}
@@ -36,6 +36,7 @@ type Store interface {
 type Service interface {
 	BranchGraph(ctx context.Context, opts *spice.BranchGraphOptions) (*spice.BranchGraph, error)
 	Restack(ctx context.Context, name string) (*spice.RestackResponse, error)
+	PreviewRestack(ctx context.Context, name string) error
 	RebaseRescue(ctx context.Context, req spice.RebaseRescueRequest) error
 }
 
@@ -99,13 +100,112 @@ func (h *Handler) Restack(ctx context.Context, req *Request) (int, error) {
 	must.NotBeBlankf(req.Branch, "branch must not be blank")
 	must.NotBeEmptyf(req.ContinueCommand, "continue command must not be set")
 
+	branchesToRestack, err := h.resolveBranches(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	var restackCount int
+loop:
+	for _, branch := range branchesToRestack.order {
+		res, err := h.Service.Restack(ctx, branch)
+		if err != nil {
+			var rebaseErr *git.RebaseInterruptError
+			switch {
+			case errors.As(err, &rebaseErr):
+				// If the rebase is interrupted by a conflict,
+				// we'll resume by re-running this command.
+				return 0, h.Service.RebaseRescue(ctx, spice.RebaseRescueRequest{
+					Err:     rebaseErr,
+					Command: req.ContinueCommand,
+					Branch:  req.Branch,
+					Message: fmt.Sprintf("interrupted: restack branch %q", branch),
+				})
+
+			case errors.Is(err, state.ErrNotExist):
+				h.Log.Errorf("%v: branch not tracked: run 'gs branch track %v' to track it", branch, branch)
+				return 0, errors.New("untracked branch")
+
+			case errors.Is(err, spice.ErrAlreadyRestacked):
+				h.Log.Infof("%v: branch does not need to be restacked.", branch)
+				continue loop
+
+			default:
+				return 0, fmt.Errorf("restack branch %q: %w", branch, err)
+			}
+		}
+
+		h.Log.Infof("%v: restacked on %v", branch, res.Base)
+		restackCount++
+	}
+
+	if branchesToRestack.requestBranchWT != "" && branchesToRestack.requestBranchWT != h.Worktree.RootDir() {
+		h.Log.Warnf("%v: checked out in another worktree (%v), not checking out here", req.Branch, branchesToRestack.requestBranchWT)
+	} else if restackCount > 0 {
+		if err := h.Worktree.CheckoutBranch(ctx, req.Branch); err != nil {
+			return 0, fmt.Errorf("checkout branch %v: %w", req.Branch, err)
+		}
+	}
+
+	return restackCount, nil
+}
+
+// Preflight reports which of the branches that a [Handler.Restack] call
+// with the same request would touch are predicted to conflict against
+// their base, without actually rebasing or otherwise touching the
+// working tree.
+//
+// This lets callers warn the user, or let them abort, before starting
+// a restack operation that will leave things in a partially-rebased
+// state on failure.
+func (h *Handler) Preflight(ctx context.Context, req *Request) ([]string, error) {
+	must.NotBeBlankf(req.Branch, "branch must not be blank")
+
+	branchesToRestack, err := h.resolveBranches(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var conflicted []string
+	for _, branch := range branchesToRestack.order {
+		err := h.Service.PreviewRestack(ctx, branch)
+		switch {
+		case err == nil, errors.Is(err, spice.ErrAlreadyRestacked):
+			// Clean, or nothing to do.
+		default:
+			var conflictErr *spice.RestackConflictError
+			if !errors.As(err, &conflictErr) {
+				return conflicted, fmt.Errorf("preview restack of %q: %w", branch, err)
+			}
+			conflicted = append(conflicted, branch)
+		}
+	}
+
+	return conflicted, nil
+}
+
+// resolvedBranches is the outcome of resolving a [Request]
+// to a concrete, restack-ordered list of branches.
+type resolvedBranches struct {
+	// order is the branches to restack, in restacking order.
+	order []string
+
+	// requestBranchWT is the worktree of the request's Branch,
+	// if it is checked out somewhere other than the current worktree.
+	requestBranchWT string
+}
+
+// resolveBranches determines which branches a restack request applies to,
+// in restack order, accounting for worktrees and branches whose base
+// was skipped for the same reason.
+func (h *Handler) resolveBranches(ctx context.Context, req *Request) (resolvedBranches, error) {
 	req.Scope = cmp.Or(req.Scope, ScopeBranch) // 0 = ScopeBranch
 
 	branchGraph, err := h.Service.BranchGraph(ctx, &spice.BranchGraphOptions{
 		IncludeWorktrees: true,
 	})
 	if err != nil {
-		return 0, fmt.Errorf("load branch graph: %w", err)
+		return resolvedBranches{}, fmt.Errorf("load branch graph: %w", err)
 	}
 
 	var branchesToRestack []string // branches in restack order
@@ -129,7 +229,7 @@ func (h *Handler) Restack(ctx context.Context, req *Request) (int, error) {
 			// If we're explicitly only trying to restack trunk,
 			// fail the operation.
 			if req.Scope == ScopeBranch {
-				return 0, errors.New("trunk cannot be restacked")
+				return resolvedBranches{}, errors.New("trunk cannot be restacked")
 			}
 		} else {
 			branchesToRestack = append(branchesToRestack, req.Branch)
@@ -189,49 +289,9 @@ func (h *Handler) Restack(ctx context.Context, req *Request) (int, error) {
 
 		branchesToActuallyRestack = append(branchesToActuallyRestack, branch)
 	}
-	branchesToRestack = branchesToActuallyRestack
-
-	var restackCount int
-loop:
-	for _, branch := range branchesToRestack {
-		res, err := h.Service.Restack(ctx, branch)
-		if err != nil {
-			var rebaseErr *git.RebaseInterruptError
-			switch {
-			case errors.As(err, &rebaseErr):
-				// If the rebase is interrupted by a conflict,
-				// we'll resume by re-running this command.
-				return 0, h.Service.RebaseRescue(ctx, spice.RebaseRescueRequest{
-					Err:     rebaseErr,
-					Command: req.ContinueCommand,
-					Branch:  req.Branch,
-					Message: fmt.Sprintf("interrupted: restack branch %q", branch),
-				})
-
-			case errors.Is(err, state.ErrNotExist):
-				h.Log.Errorf("%v: branch not tracked: run 'gs branch track %v' to track it", branch, branch)
-				return 0, errors.New("untracked branch")
-
-			case errors.Is(err, spice.ErrAlreadyRestacked):
-				h.Log.Infof("%v: branch does not need to be restacked.", branch)
-				continue loop
-
-			default:
-				return 0, fmt.Errorf("restack branch %q: %w", branch, err)
-			}
-		}
-
-		h.Log.Infof("%v: restacked on %v", branch, res.Base)
-		restackCount++
-	}
-
-	if requestBranchWT != "" && requestBranchWT != currentWT {
-		h.Log.Warnf("%v: checked out in another worktree (%v), not checking out here", req.Branch, requestBranchWT)
-	} else if restackCount > 0 {
-		if err := h.Worktree.CheckoutBranch(ctx, req.Branch); err != nil {
-			return 0, fmt.Errorf("checkout branch %v: %w", req.Branch, err)
-		}
-	}
 
-	return restackCount, nil
+	return resolvedBranches{
+		order:           branchesToActuallyRestack,
+		requestBranchWT: requestBranchWT,
+	}, nil
 }
@@ -1,15 +1,24 @@
 package restack
 
-import "context"
+import (
+	"context"
+	"strings"
+)
 
 // RestackStack restacks the stack of the given branch.
 // This includes all upstack and downtrack branches,
 // as well as the branch itself.
 func (h *Handler) RestackStack(ctx context.Context, branch string) error {
-	_, err := h.Restack(ctx, &Request{
+	req := &Request{
 		Branch:          branch,
 		Scope:           ScopeStack,
 		ContinueCommand: []string{"stack", "restack"},
-	})
+	}
+
+	if conflicted, err := h.Preflight(ctx, req); err == nil && len(conflicted) > 0 {
+		h.Log.Warnf("This restack will conflict on: %s", strings.Join(conflicted, ", "))
+	}
+
+	_, err := h.Restack(ctx, req)
 	return err
 }
@@ -3,6 +3,7 @@ package restack
 import (
 	"cmp"
 	"context"
+	"strings"
 )
 
 // UpstackOptions holds options for restacking the upstack of a branch.
@@ -24,6 +25,11 @@ func (h *Handler) RestackUpstack(ctx context.Context, branch string, opts *Upsta
 		req.Scope = ScopeUpstackExclusive
 		req.ContinueCommand = []string{"upstack", "restack", "--skip-start"}
 	}
+
+	if conflicted, err := h.Preflight(ctx, req); err == nil && len(conflicted) > 0 {
+		h.Log.Warnf("This restack will conflict on: %s", strings.Join(conflicted, ", "))
+	}
+
 	_, err := h.Restack(ctx, req)
 	return err
 }
@@ -1,10 +1,8 @@
 package submit
 
 import (
-	"cmp"
 	"context"
 	"errors"
-	"os"
 	"strings"
 
 	"go.abhg.dev/gs/internal/forge"
@@ -122,9 +120,9 @@ func (f *branchSubmitForm) templateField(changeTemplatesCh <-chan []*forge.Chang
 }
 
 func (f *branchSubmitForm) bodyField(body *string) ui.Field {
-	editor := ui.Editor{
-		Command: gitEditor(f.ctx, f.repo),
-		Ext:     "md",
+	editor, err := ui.DefaultEditor(f.ctx, f.repo)
+	if err != nil {
+		f.log.Warnf("resolve editor: %v", err)
 	}
 
 	return ui.Defer(func() ui.Field {
@@ -154,17 +152,3 @@ func (f *branchSubmitForm) draftField(draft *bool) ui.Field {
 		WithTitle("Draft").
 		WithDescription("Mark the change as a draft?")
 }
-
-// gitEditor returns the editor to use
-// to prompt the user to fill information.
-//
-// TODO: extract this somewhere
-func gitEditor(ctx context.Context, repo GitRepository) string {
-	gitEditor, err := repo.Var(ctx, "GIT_EDITOR")
-	if err != nil {
-		// 'git var GIT_EDITOR' will basically never fail,
-		// but if it does, fall back to EDITOR or vi.
-		return cmp.Or(os.Getenv("EDITOR"), "vi")
-	}
-	return gitEditor
-}
@@ -428,14 +428,14 @@ func (h *Handler) findForgeFinishedBranches(
 				changeIDs[i] = b.Change
 			}
 
-			states, err := h.RemoteRepository.ChangesStates(ctx, changeIDs)
+			statuses, err := h.RemoteRepository.ChangeStatuses(ctx, changeIDs)
 			if err != nil {
 				h.Log.Error("Failed to query CR status", "error", err)
 				return
 			}
 
-			for i, state := range states {
-				submittedBranches[i].State = state
+			for i, status := range statuses {
+				submittedBranches[i].State = status.State
 			}
 		}()
 	}
@@ -0,0 +1,97 @@
+package httptest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+// graphQLRequest is the shape of a standard GraphQL-over-HTTP request
+// body: https://graphql.org/learn/serving-over-http/.
+type graphQLRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// graphQLMatcher wraps fallback with a matcher that, for requests
+// whose body parses as a [graphQLRequest], compares a canonicalized
+// form of the query and variables instead of raw bytes. This lets a
+// cassette recorded with one variable ordering (or insignificant
+// whitespace differences in the query) still replay against a request
+// that doesn't match byte-for-byte.
+//
+// Requests that don't parse as GraphQL are matched with fallback.
+func graphQLMatcher(fallback func(*http.Request, cassette.Request) bool) func(*http.Request, cassette.Request) bool {
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method || r.URL.String() != i.URL {
+			return false
+		}
+
+		reqQuery, ok := canonicalGraphQLBody(r)
+		if !ok {
+			return fallback(r, i)
+		}
+
+		cassetteQuery, ok := canonicalGraphQLString(i.Body)
+		if !ok {
+			return fallback(r, i)
+		}
+
+		return reqQuery == cassetteQuery
+	}
+}
+
+// canonicalGraphQLBody reads and restores r.Body, then canonicalizes
+// it as a GraphQL request. Reports false if the body isn't one.
+func canonicalGraphQLBody(r *http.Request) (string, bool) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return "", false
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return "", false
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	return canonicalGraphQLString(string(body))
+}
+
+// canonicalGraphQLString canonicalizes a raw request body as a
+// GraphQL request, reporting false if it isn't shaped like one.
+func canonicalGraphQLString(body string) (string, bool) {
+	if body == "" {
+		return "", false
+	}
+
+	var req graphQLRequest
+	if err := json.Unmarshal([]byte(body), &req); err != nil || req.Query == "" {
+		return "", false
+	}
+
+	// encoding/json marshals map keys in sorted order, so this
+	// normalizes variable ordering for free.
+	variables, err := json.Marshal(req.Variables)
+	if err != nil {
+		return "", false
+	}
+
+	var b strings.Builder
+	b.WriteString(req.OperationName)
+	b.WriteByte('\n')
+	b.WriteString(normalizeGraphQLQuery(req.Query))
+	b.WriteByte('\n')
+	b.Write(variables)
+	return b.String(), true
+}
+
+// normalizeGraphQLQuery collapses insignificant whitespace in a
+// GraphQL query document so that reformatted queries compare equal.
+func normalizeGraphQLQuery(query string) string {
+	return strings.Join(strings.Fields(query), " ")
+}
@@ -0,0 +1,100 @@
+package httptest
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+func byteMatcher(t *testing.T) func(*http.Request, cassette.Request) bool {
+	return func(r *http.Request, i cassette.Request) bool {
+		t.Helper()
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		return r.Method == i.Method && r.URL.String() == i.URL && string(body) == i.Body
+	}
+}
+
+func newPostRequest(t *testing.T, body string) *http.Request {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPost, "https://example.com/graphql", strings.NewReader(body))
+	assert.NoError(t, err)
+	return req
+}
+
+func TestGraphQLMatcher_reorderedVariables(t *testing.T) {
+	cassetteReq := cassette.Request{
+		Method: http.MethodPost,
+		URL:    "https://example.com/graphql",
+		Body:   `{"query":"query Foo($a: Int, $b: Int) { foo(a: $a, b: $b) }","variables":{"a":1,"b":2}}`,
+	}
+
+	req := newPostRequest(t, `{"variables":{"b":2,"a":1},"query":"query Foo($a: Int, $b: Int) { foo(a: $a, b: $b) }"}`)
+
+	matcher := graphQLMatcher(byteMatcher(t))
+	assert.True(t, matcher(req, cassetteReq), "requests with reordered variables should match")
+}
+
+func TestGraphQLMatcher_reformattedQuery(t *testing.T) {
+	cassetteReq := cassette.Request{
+		Method: http.MethodPost,
+		URL:    "https://example.com/graphql",
+		Body:   `{"query":"query Foo {\n  foo\n}","variables":{}}`,
+	}
+
+	req := newPostRequest(t, `{"query":"query Foo { foo }","variables":{}}`)
+
+	matcher := graphQLMatcher(byteMatcher(t))
+	assert.True(t, matcher(req, cassetteReq), "requests with reformatted whitespace should match")
+}
+
+func TestGraphQLMatcher_differentVariables(t *testing.T) {
+	cassetteReq := cassette.Request{
+		Method: http.MethodPost,
+		URL:    "https://example.com/graphql",
+		Body:   `{"query":"query Foo($a: Int) { foo(a: $a) }","variables":{"a":1}}`,
+	}
+
+	req := newPostRequest(t, `{"query":"query Foo($a: Int) { foo(a: $a) }","variables":{"a":2}}`)
+
+	matcher := graphQLMatcher(byteMatcher(t))
+	assert.False(t, matcher(req, cassetteReq), "requests with different variable values should not match")
+}
+
+func TestGraphQLMatcher_fallsBackForNonGraphQL(t *testing.T) {
+	cassetteReq := cassette.Request{
+		Method: http.MethodPost,
+		URL:    "https://example.com/graphql",
+		Body:   `not json`,
+	}
+
+	req := newPostRequest(t, `also not json`)
+
+	var fellBack bool
+	fallback := func(*http.Request, cassette.Request) bool {
+		fellBack = true
+		return true
+	}
+
+	matcher := graphQLMatcher(fallback)
+	assert.True(t, matcher(req, cassetteReq))
+	assert.True(t, fellBack, "non-GraphQL bodies should be matched by the fallback")
+}
+
+func TestGraphQLMatcher_methodOrURLMismatch(t *testing.T) {
+	cassetteReq := cassette.Request{
+		Method: http.MethodPost,
+		URL:    "https://example.com/graphql",
+		Body:   `{"query":"query Foo { foo }"}`,
+	}
+
+	req := newPostRequest(t, `{"query":"query Foo { foo }"}`)
+	req.Method = http.MethodGet
+
+	matcher := graphQLMatcher(byteMatcher(t))
+	assert.False(t, matcher(req, cassetteReq))
+}
@@ -33,6 +33,20 @@ type TransportRecorderOptions struct {
 	) http.RoundTripper
 
 	Matcher func(*http.Request, cassette.Request) bool
+
+	// MatchGraphQL enables GraphQL-aware request matching.
+	//
+	// When both the incoming request and a recorded interaction have
+	// a JSON body shaped like a GraphQL request ({query, variables,
+	// operationName}), they're compared by a canonicalized form of
+	// that body (whitespace-normalized query, sorted variable keys)
+	// instead of Matcher's raw byte comparison. Requests that aren't
+	// recognized as GraphQL fall back to Matcher.
+	MatchGraphQL bool
+
+	// AfterCapture, if set, runs on each interaction after it's
+	// captured, in addition to the built-in header allowlist.
+	AfterCapture func(*cassette.Interaction) error
 }
 
 // NewTransportRecorder builds a new HTTP request recorder/replayer
@@ -86,10 +100,24 @@ func NewTransportRecorder(
 		}
 	}
 
+	if opts.AfterCapture != nil {
+		allowlistHook := afterCaptureHook
+		userHook := opts.AfterCapture
+		afterCaptureHook = func(i *cassette.Interaction) error {
+			if err := allowlistHook(i); err != nil {
+				return err
+			}
+			return userHook(i)
+		}
+	}
+
 	matcher := cassette.DefaultMatcher
 	if opts.Matcher != nil {
 		matcher = opts.Matcher
 	}
+	if opts.MatchGraphQL {
+		matcher = graphQLMatcher(matcher)
+	}
 
 	rec, err := recorder.New(filepath.Join("testdata", "fixtures", name),
 		recorder.WithMode(mode),
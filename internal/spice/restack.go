@@ -43,45 +43,7 @@ func (s *Service) Restack(ctx context.Context, name string) (*RestackResponse, e
 	// We will proceed with the restack.
 
 	baseHash := restackErr.BaseHash
-	upstream := b.BaseHash
-
-	// Case:
-	// Recorded base hash is super out of date,
-	// and is not an ancestor of the current branch.
-	// In that case, use fork point as a hail mary
-	// to guess the upstream start point.
-	//
-	// For context, fork point attempts to find the point
-	// where the current branch diverged from the branch it
-	// was originally forked from.
-	// For example, given:
-	//
-	//  ---X---A'---o foo
-	//      \
-	//       A
-	//        \
-	//         B---o---o bar
-	//
-	// If bar branched from foo, when foo was at A,
-	// and then we amended foo to get A',
-	// bar will still refer to A.
-	//
-	// In this case, merge-base --fork-point will give us A,
-	// and that should be the upstream (commit to start rebasing from)
-	// if the recorded base hash is out of date
-	// because the user changed something externally.
-	if !s.repo.IsAncestor(ctx, baseHash, b.Head) {
-		forkPoint, err := s.repo.ForkPoint(ctx, b.Base, name)
-		if err == nil {
-			if upstream != forkPoint {
-				s.log.Debug("Recorded base hash is out of date. Restacking from fork point.",
-					"base", b.Base,
-					"branch", name,
-					"forkPoint", forkPoint)
-			}
-			upstream = forkPoint
-		}
-	}
+	upstream := s.restackUpstream(ctx, name, b, baseHash)
 
 	if err := s.repo.Rebase(ctx, git.RebaseRequest{
 		Onto:      baseHash.String(),
@@ -110,6 +72,117 @@ func (s *Service) Restack(ctx context.Context, name string) (*RestackResponse, e
 	}, nil
 }
 
+// restackUpstream determines the commit that a branch's own commits
+// should be rebased from: normally b.BaseHash, the recorded base hash.
+//
+// Case:
+// Recorded base hash is super out of date,
+// and is not an ancestor of the current branch.
+// In that case, use fork point as a hail mary
+// to guess the upstream start point.
+//
+// For context, fork point attempts to find the point
+// where the current branch diverged from the branch it
+// was originally forked from.
+// For example, given:
+//
+//	---X---A'---o foo
+//	    \
+//	     A
+//	      \
+//	       B---o---o bar
+//
+// If bar branched from foo, when foo was at A,
+// and then we amended foo to get A',
+// bar will still refer to A.
+//
+// In this case, merge-base --fork-point will give us A,
+// and that should be the upstream (commit to start rebasing from)
+// if the recorded base hash is out of date
+// because the user changed something externally.
+func (s *Service) restackUpstream(ctx context.Context, name string, b *LookupBranchResponse, baseHash git.Hash) git.Hash {
+	upstream := b.BaseHash
+	if !s.repo.IsAncestor(ctx, baseHash, b.Head) {
+		forkPoint, err := s.repo.ForkPoint(ctx, b.Base, name)
+		if err == nil {
+			if upstream != forkPoint {
+				s.log.Debug("Recorded base hash is out of date. Restacking from fork point.",
+					"base", b.Base,
+					"branch", name,
+					"forkPoint", forkPoint)
+			}
+			upstream = forkPoint
+		}
+	}
+	return upstream
+}
+
+// RestackConflictError indicates that restacking a branch onto its
+// base branch would produce conflicts, as predicted by
+// [git.Repository.MergeTree] without touching the working tree.
+type RestackConflictError struct {
+	// Branch is the branch that would conflict.
+	Branch string
+
+	// Base is the base branch it would conflict against.
+	Base string
+
+	// Err describes the conflicting files.
+	Err *git.MergeTreeConflictError
+}
+
+func (e *RestackConflictError) Error() string {
+	return fmt.Sprintf("%v: restacking onto %v would conflict: %v", e.Branch, e.Base, e.Err)
+}
+
+func (e *RestackConflictError) Unwrap() error {
+	return e.Err
+}
+
+// PreviewRestack reports whether restacking the given branch on top of
+// its base branch would produce conflicts, without touching the
+// working tree or recording any state changes.
+//
+// Like [Service.Restack], it returns [ErrAlreadyRestacked]
+// if the branch does not need to be restacked.
+// It returns [RestackConflictError] if the restack would conflict.
+func (s *Service) PreviewRestack(ctx context.Context, name string) error {
+	b, err := s.LookupBranch(ctx, name)
+	if err != nil {
+		return err // includes ErrNotExist
+	}
+
+	err = s.VerifyRestacked(ctx, name)
+	if err == nil {
+		return ErrAlreadyRestacked
+	}
+	var restackErr *BranchNeedsRestackError
+	if !errors.As(err, &restackErr) {
+		return fmt.Errorf("verify restacked: %w", err)
+	}
+
+	baseHash := restackErr.BaseHash
+	upstream := s.restackUpstream(ctx, name, b, baseHash)
+
+	if _, err := s.repo.MergeTree(ctx, git.MergeTreeRequest{
+		MergeBase: upstream.String(),
+		Branch1:   baseHash.String(),
+		Branch2:   b.Head.String(),
+	}); err != nil {
+		var conflictErr *git.MergeTreeConflictError
+		if errors.As(err, &conflictErr) {
+			return &RestackConflictError{
+				Branch: name,
+				Base:   restackErr.Base,
+				Err:    conflictErr,
+			}
+		}
+		return fmt.Errorf("merge-tree: %w", err)
+	}
+
+	return nil
+}
+
 // BranchNeedsRestackError is returned by [Service.VerifyRestacked]
 // when a branch needs to be restacked.
 type BranchNeedsRestackError struct {
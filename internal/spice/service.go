@@ -30,6 +30,11 @@ type GitRepository interface {
 	// PeelToCommit returns the commit hash for the given commit-ish.
 	PeelToCommit(ctx context.Context, ref string) (git.Hash, error)
 
+	// MergeTree performs a merge without touching the index or working
+	// tree, reporting conflicts, if any, without actually rebasing or
+	// merging anything.
+	MergeTree(ctx context.Context, req git.MergeTreeRequest) (git.Hash, error)
+
 	// LocalBranches returns an iterator over local branches
 	LocalBranches(ctx context.Context, opts *git.LocalBranchesOptions) iter.Seq2[git.LocalBranch, error]
 
@@ -0,0 +1,79 @@
+// Package sshkey provides helpers for locating or generating
+// an SSH key pair to use for Git operations.
+package sshkey
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// Ensure looks for an ed25519 key pair at the conventional location
+// inside the user's SSH directory (~/.ssh/id_ed25519), generating one
+// if it doesn't already exist.
+//
+// It returns the path to the private key file, the corresponding public
+// key, and whether a new key pair was generated.
+func Ensure() (path string, pub ssh.PublicKey, generated bool, err error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", nil, false, fmt.Errorf("find home directory: %w", err)
+	}
+
+	dir := filepath.Join(home, ".ssh")
+	keyPath := filepath.Join(dir, "id_ed25519")
+	pubPath := keyPath + ".pub"
+
+	if pubBytes, err := os.ReadFile(pubPath); err == nil {
+		pub, _, _, _, err := ssh.ParseAuthorizedKey(pubBytes)
+		if err != nil {
+			return "", nil, false, fmt.Errorf("parse existing public key %v: %w", pubPath, err)
+		}
+		return keyPath, pub, false, nil
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", nil, false, fmt.Errorf("create %v: %w", dir, err)
+	}
+
+	edPub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", nil, false, fmt.Errorf("generate key pair: %w", err)
+	}
+
+	sshPub, err := ssh.NewPublicKey(edPub)
+	if err != nil {
+		return "", nil, false, fmt.Errorf("convert public key: %w", err)
+	}
+
+	block, err := ssh.MarshalPrivateKey(priv, "git-spice")
+	if err != nil {
+		return "", nil, false, fmt.Errorf("marshal private key: %w", err)
+	}
+
+	if err := os.WriteFile(keyPath, pem.EncodeToMemory(block), 0o600); err != nil {
+		return "", nil, false, fmt.Errorf("write private key %v: %w", keyPath, err)
+	}
+	if err := os.WriteFile(pubPath, ssh.MarshalAuthorizedKey(sshPub), 0o644); err != nil {
+		return "", nil, false, fmt.Errorf("write public key %v: %w", pubPath, err)
+	}
+
+	return keyPath, sshPub, true, nil
+}
+
+// Fingerprint reports the SHA256 fingerprint of an authorized-keys-format
+// public key, e.g. as returned by a forge's "list SSH keys" API.
+//
+// It returns an error if authorizedKey cannot be parsed.
+func Fingerprint(authorizedKey string) (string, error) {
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(authorizedKey))
+	if err != nil {
+		return "", fmt.Errorf("parse public key: %w", err)
+	}
+	return ssh.FingerprintSHA256(pub), nil
+}
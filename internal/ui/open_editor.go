@@ -1,6 +1,9 @@
 package ui
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"os"
@@ -17,6 +20,13 @@ import (
 type OpenEditorKeyMap struct {
 	Edit   key.Binding
 	Accept key.Binding
+
+	// Retry re-opens the editor after a validation failure.
+	Retry key.Binding
+
+	// Abort gives up on the field after a validation failure,
+	// discarding the draft.
+	Abort key.Binding
 }
 
 // DefaultOpenEditorKeyMap is the default key map for an [OpenEditor] field.
@@ -29,12 +39,21 @@ var DefaultOpenEditorKeyMap = OpenEditorKeyMap{
 		key.WithKeys("enter", "tab"),
 		key.WithHelp("enter/tab", "accept"),
 	),
+	Retry: key.NewBinding(
+		key.WithKeys("e"),
+		key.WithHelp("e", "retry"),
+	),
+	Abort: key.NewBinding(
+		key.WithKeys("q"),
+		key.WithHelp("q", "abort"),
+	),
 }
 
 // OpenEditorStyle defines the display style for [OpenEditor].
 type OpenEditorStyle struct {
 	Key    lipgloss.Style // how to highlight keys
 	Editor lipgloss.Style
+	Error  lipgloss.Style
 
 	NoEditorMessage string
 }
@@ -43,24 +62,19 @@ type OpenEditorStyle struct {
 var DefaultOpenEditorStyle = OpenEditorStyle{
 	Key:             NewStyle().Foreground(Magenta),
 	Editor:          NewStyle().Foreground(Green),
+	Error:           NewStyle().Foreground(Red),
 	NoEditorMessage: "please set an editor",
 }
 
 // Editor configures the editor to open.
 type Editor struct {
-	// Command is the editor command to run.
-	//
-	// This may be a shell command like:
-	//
-	//	FOO=bar gvim --nofork
-	//
-	// or a binary name like:
-	//
-	//	nvim
-	//
-	// For the former case, we'll use 'sh -c' to run the command.
+	// Command is the name of the editor binary to run.
 	Command string
 
+	// Args are the arguments to pass to Command,
+	// before the name of the file to edit.
+	Args []string
+
 	// Ext is the extension to assign to the file
 	// before opening the editor.
 	//
@@ -68,12 +82,20 @@ type Editor struct {
 	Ext string
 }
 
-// DefaultEditor returns the default editor configuration.
-func DefaultEditor() Editor {
+// DefaultEditor resolves the editor configuration to use
+// following git's own editor resolution order.
+// See [execedit.Resolve] for the exact order used.
+func DefaultEditor(ctx context.Context, repo execedit.GitVarer) (Editor, error) {
+	cmd, args, err := execedit.Resolve(ctx, repo)
+	if err != nil {
+		return Editor{}, err
+	}
+
 	return Editor{
-		Command: os.Getenv("EDITOR"),
+		Command: cmd,
+		Args:    args,
 		Ext:     "md",
-	}
+	}, nil
 }
 
 // OpenEditor is a dialog that asks the user to press a key
@@ -83,11 +105,53 @@ type OpenEditor struct {
 	Style  OpenEditorStyle
 	Editor Editor
 
+	// CommentPrefix is the prefix used to mark a line in the buffer
+	// as a comment. Comment lines, and anything from a scissors line
+	// (e.g. "# ------------------------ >8 ------------------------")
+	// onwards, are stripped from the buffer before it's validated or
+	// accepted.
+	//
+	// Defaults to "#".
+	CommentPrefix string
+
+	// Template, if set, is commented-out guidance text prepended to
+	// the buffer the first time the editor opens: e.g. the commits in
+	// a branch, a diffstat, or the target base branch. It's visible to
+	// the user while editing but is stripped from the final value like
+	// any other comment.
+	Template string
+
+	// Validate, if set, is run against the buffer contents
+	// every time the editor closes.
+	//
+	// If any function returns an error, the error is prepended to the
+	// buffer as comment lines, and the user is asked to re-open the
+	// editor (KeyMap.Retry) to address it without losing their draft,
+	// or to abort (KeyMap.Abort).
+	Validate []func([]byte) error
+
 	title string
 	desc  string
 
 	value *string
 	err   error
+
+	// validateErr holds the outcome of the last failed validation
+	// while we wait for the user to retry or abort.
+	validateErr error
+
+	// opened is true once the editor has been launched at least once.
+	// Template is only injected into the very first draft.
+	opened bool
+
+	// preEditHash is the hash of the buffer as it was written to disk,
+	// right before the editor was last launched.
+	preEditHash [sha256.Size]byte
+
+	// unchanged holds the raw (unstripped) buffer contents while we
+	// wait for the user to decide what to do about an editor session
+	// that closed without changing the buffer.
+	unchanged []byte
 }
 
 var _ Field = (*OpenEditor)(nil)
@@ -97,10 +161,11 @@ var _ Field = (*OpenEditor)(nil)
 // or accept the current value.
 func NewOpenEditor(editor Editor) *OpenEditor {
 	return &OpenEditor{
-		KeyMap: DefaultOpenEditorKeyMap,
-		Style:  DefaultOpenEditorStyle,
-		Editor: editor,
-		value:  new(string),
+		KeyMap:        DefaultOpenEditorKeyMap,
+		Style:         DefaultOpenEditorStyle,
+		Editor:        editor,
+		CommentPrefix: "#",
+		value:         new(string),
 	}
 }
 
@@ -118,6 +183,23 @@ func (a *OpenEditor) WithValue(value *string) *OpenEditor {
 	return a
 }
 
+// WithTemplate sets commented-out guidance text to prepend to the
+// buffer the first time the editor opens.
+func (a *OpenEditor) WithTemplate(tmpl string) *OpenEditor {
+	a.Template = tmpl
+	return a
+}
+
+// WithValidate adds validators that the edited buffer must satisfy.
+//
+// Validators run in order after the editor closes. The first error
+// returned, if any, is reported to the user, who can then re-open
+// the editor with the same draft to fix it, or abort.
+func (a *OpenEditor) WithValidate(validate ...func([]byte) error) *OpenEditor {
+	a.Validate = append(a.Validate, validate...)
+	return a
+}
+
 // WithTitle sets the title for the field.
 func (a *OpenEditor) WithTitle(title string) *OpenEditor {
 	a.title = title
@@ -147,58 +229,61 @@ func (a *OpenEditor) Init() tea.Cmd {
 
 type updateEditorValueMsg []byte
 
+// ErrEditAborted is returned by [OpenEditor.Err]
+// when the user submits a buffer that is empty once comments are stripped,
+// mirroring git's "Aborting due to empty message" behavior,
+// or presses the abort key after a validation failure or an unchanged buffer.
+var ErrEditAborted = errors.New("aborted: empty buffer")
+
 // Update receives a new event from bubbletea
 // and updates the field's internal state.
 func (a *OpenEditor) Update(msg tea.Msg) tea.Cmd {
 	switch msg := msg.(type) {
 	case updateEditorValueMsg:
-		*a.value = string(msg)
+		if sha256.Sum256(msg) == a.preEditHash {
+			// The buffer is identical to what we wrote out.
+			// If it's empty once comments are stripped, abort as
+			// acceptDraft would. Otherwise the user may have closed
+			// the editor without meaning to, so confirm before accepting it.
+			if len(bytes.TrimSpace(a.stripComments(msg))) == 0 {
+				a.err = ErrEditAborted
+				return tea.Quit
+			}
+			a.unchanged = msg
+			return nil
+		}
 
-		// The field is accepted automatically after the editor is
-		// closed.
-		return AcceptField
+		return a.acceptDraft(msg)
 
 	case tea.KeyMsg:
 		switch {
-		case key.Matches(msg, a.KeyMap.Edit) && a.Editor.Command != "":
-			ext := strings.TrimPrefix(a.Editor.Ext, ".")
+		case a.unchanged != nil && key.Matches(msg, a.KeyMap.Retry):
+			a.unchanged = nil
+			return a.openEditor()
 
-			tmpFile, err := osutil.TempFilePath("", "*."+ext)
-			if err != nil {
-				a.err = fmt.Errorf("create temporary file: %w", err)
-				return tea.Quit
-			}
-
-			if err := os.WriteFile(tmpFile, []byte(*a.value), 0o644); err != nil {
-				a.err = errors.Join(
-					fmt.Errorf("write to temporary file: %w", err),
-					os.Remove(tmpFile),
-				)
-				return tea.Quit
-			}
+		case a.unchanged != nil && key.Matches(msg, a.KeyMap.Accept):
+			raw := a.unchanged
+			a.unchanged = nil
+			return a.acceptDraft(raw)
 
-			cmd := execedit.Command(a.Editor.Command, tmpFile)
-			return tea.ExecProcess(cmd, func(err error) tea.Msg {
-				defer func() { _ = os.Remove(tmpFile) }()
+		case a.unchanged != nil && key.Matches(msg, a.KeyMap.Abort):
+			a.err = ErrEditAborted
+			return tea.Quit
 
-				if err != nil {
-					a.err = fmt.Errorf("run editor: %w", err)
-					return tea.Quit
-				}
+		case a.validateErr != nil && key.Matches(msg, a.KeyMap.Retry):
+			return a.openEditor()
 
-				content, err := os.ReadFile(tmpFile)
-				if err != nil {
-					a.err = fmt.Errorf("read temporary file: %w", err)
-					return tea.Quit
-				}
+		case a.validateErr != nil && key.Matches(msg, a.KeyMap.Abort):
+			a.err = ErrEditAborted
+			return tea.Quit
 
-				return updateEditorValueMsg(content)
-			})
+		case a.validateErr == nil && key.Matches(msg, a.KeyMap.Edit) && a.Editor.Command != "":
+			return a.openEditor()
 
-		case key.Matches(msg, a.KeyMap.Accept):
+		case a.validateErr == nil && key.Matches(msg, a.KeyMap.Accept):
 			return AcceptField
 
-		case a.Editor.Command == "":
+		case a.validateErr == nil && a.Editor.Command == "":
 			a.err = errors.New(a.Style.NoEditorMessage)
 			return tea.Quit
 		}
@@ -207,11 +292,160 @@ func (a *OpenEditor) Update(msg tea.Msg) tea.Cmd {
 	return nil
 }
 
+// acceptDraft strips comments from raw, validates what remains, and
+// either accepts the field, re-prompts for a validation failure, or
+// aborts on an empty buffer.
+func (a *OpenEditor) acceptDraft(raw []byte) tea.Cmd {
+	content := a.stripComments(raw)
+
+	if len(bytes.TrimSpace(content)) == 0 {
+		a.err = ErrEditAborted
+		return tea.Quit
+	}
+
+	for _, validate := range a.Validate {
+		if err := validate(content); err != nil {
+			a.validateErr = err
+			*a.value = string(a.prependValidateErr(content, err))
+			return nil
+		}
+	}
+
+	a.validateErr = nil
+	*a.value = string(content)
+
+	// The field is accepted automatically after the editor is closed.
+	return AcceptField
+}
+
+// openEditor writes the current value to a temporary file
+// and opens the configured editor on it.
+func (a *OpenEditor) openEditor() tea.Cmd {
+	ext := strings.TrimPrefix(a.Editor.Ext, ".")
+
+	tmpFile, err := osutil.TempFilePath("", "*."+ext)
+	if err != nil {
+		a.err = fmt.Errorf("create temporary file: %w", err)
+		return tea.Quit
+	}
+
+	buf := []byte(*a.value)
+	if !a.opened {
+		buf = a.prependTemplate(buf)
+		a.opened = true
+	}
+	a.preEditHash = sha256.Sum256(buf)
+
+	if err := os.WriteFile(tmpFile, buf, 0o644); err != nil {
+		a.err = errors.Join(
+			fmt.Errorf("write to temporary file: %w", err),
+			os.Remove(tmpFile),
+		)
+		return tea.Quit
+	}
+
+	cmd := execedit.Command(a.Editor.Command, a.Editor.Args, tmpFile)
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		defer func() { _ = os.Remove(tmpFile) }()
+
+		if err != nil {
+			a.err = fmt.Errorf("run editor: %w", err)
+			return tea.Quit
+		}
+
+		content, err := os.ReadFile(tmpFile)
+		if err != nil {
+			a.err = fmt.Errorf("read temporary file: %w", err)
+			return tea.Quit
+		}
+
+		return updateEditorValueMsg(content)
+	})
+}
+
+// scissorsLine returns the marker that, git-commit --verbose-style,
+// cuts off the rest of the buffer: it and everything after it is
+// discarded before the buffer is validated or accepted.
+func (a *OpenEditor) scissorsLine() string {
+	return a.CommentPrefix + " ------------------------ >8 ------------------------"
+}
+
+// stripComments removes every comment line (recognized by
+// [OpenEditor.CommentPrefix]) from content, including the scissors
+// line and everything after it.
+func (a *OpenEditor) stripComments(content []byte) []byte {
+	if a.CommentPrefix == "" {
+		return content
+	}
+
+	scissors := a.scissorsLine()
+	var b strings.Builder
+	for _, line := range strings.SplitAfter(string(content), "\n") {
+		if strings.TrimRight(line, "\n") == scissors {
+			break
+		}
+		if strings.HasPrefix(line, a.CommentPrefix) {
+			continue
+		}
+		b.WriteString(line)
+	}
+	return []byte(b.String())
+}
+
+// prependTemplate prepends [OpenEditor.Template] to content as a block
+// of comment lines using [OpenEditor.CommentPrefix].
+func (a *OpenEditor) prependTemplate(content []byte) []byte {
+	if a.Template == "" {
+		return content
+	}
+
+	var b strings.Builder
+	for _, line := range strings.Split(a.Template, "\n") {
+		if line == "" {
+			fmt.Fprintf(&b, "%s\n", a.CommentPrefix)
+		} else {
+			fmt.Fprintf(&b, "%s %s\n", a.CommentPrefix, line)
+		}
+	}
+	b.Write(content)
+	return []byte(b.String())
+}
+
+// prependValidateErr prepends err to content as a block of comment lines
+// using [OpenEditor.CommentPrefix].
+func (a *OpenEditor) prependValidateErr(content []byte, err error) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s Validation failed:\n", a.CommentPrefix)
+	for _, line := range strings.Split(err.Error(), "\n") {
+		fmt.Fprintf(&b, "%s %s\n", a.CommentPrefix, line)
+	}
+	fmt.Fprintf(&b, "%s\n", a.CommentPrefix)
+	b.Write(content)
+	return []byte(b.String())
+}
+
 // Render renders the field to the screen.
 func (a *OpenEditor) Render(w Writer) {
-	fmt.Fprintf(w, "Press [%v] to open %v or [%v] to skip",
-		a.Style.Key.Render(a.KeyMap.Edit.Help().Key),
-		a.Style.Editor.Render(a.Editor.Command),
-		a.Style.Key.Render(a.KeyMap.Accept.Help().Key),
-	)
+	switch {
+	case a.unchanged != nil:
+		fmt.Fprintf(w, "Buffer is unchanged. Press [%v] to re-edit, [%v] to accept as-is, or [%v] to abort",
+			a.Style.Key.Render(a.KeyMap.Retry.Help().Key),
+			a.Style.Key.Render(a.KeyMap.Accept.Help().Key),
+			a.Style.Key.Render(a.KeyMap.Abort.Help().Key),
+		)
+
+	case a.validateErr != nil:
+		fmt.Fprintf(w, "%s\nPress [%v] to fix it or [%v] to abort",
+			a.Style.Error.Render(a.validateErr.Error()),
+			a.Style.Key.Render(a.KeyMap.Retry.Help().Key),
+			a.Style.Key.Render(a.KeyMap.Abort.Help().Key),
+		)
+
+	default:
+		fmt.Fprintf(w, "Press [%v] to open %v or [%v] to skip",
+			a.Style.Key.Render(a.KeyMap.Edit.Help().Key),
+			a.Style.Editor.Render(a.Editor.Command),
+			a.Style.Key.Render(a.KeyMap.Accept.Help().Key),
+		)
+	}
 }
@@ -12,12 +12,16 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/log"
 	"go.abhg.dev/gs/internal/ui"
 )
 
+// _timeNow is overridden in tests.
+var _timeNow = time.Now
+
 const (
 	// DefaultWidth is the default terminal width.
 	DefaultWidth = 80
@@ -26,6 +30,26 @@ const (
 	DefaultHeight = 24
 )
 
+// RobotFormat selects the on-disk representation
+// that a [RobotView] reads and writes its fixture in.
+type RobotFormat int
+
+const (
+	// RobotFormatText is the default "===\"/">"-delimited fixture format
+	// described in [RobotView]'s documentation.
+	RobotFormatText RobotFormat = iota
+
+	// RobotFormatJSONL is an opt-in, machine-parseable format
+	// that writes one JSON object per line:
+	//
+	//	{"kind": "prompt", "title": "...", "description": "...", "render": "...", "value": ..., "timestamp": "..."}
+	//
+	// "kind" is "prompt" for fixtures that fed a value into a prompt,
+	// or "log" for non-prompt output captured between prompts.
+	// "value" is omitted for "log" records.
+	RobotFormatJSONL
+)
+
 var (
 	_commentPrefix = []byte(">")
 	_separator     = []byte("===")
@@ -76,6 +100,7 @@ type RobotView struct {
 	fixtureFile  string
 	positionFile string
 	logger       *log.Logger
+	format       RobotFormat
 	w, h         int
 
 	// outputBuffer holds non-prompt output until the next prompt.
@@ -103,6 +128,11 @@ type RobotViewOptions struct {
 	//
 	// Defaults to DefaultWidth and DefaultHeight.
 	Width, Height int
+
+	// Format is the on-disk fixture format to read and write.
+	//
+	// Defaults to RobotFormatText.
+	Format RobotFormat
 }
 
 // NewRobotView creates a new [RobotView] that reads from the given
@@ -136,6 +166,7 @@ func NewRobotView(fixtureFile string, opts *RobotViewOptions) (*RobotView, error
 		positionFile: fixtureFile + ".pos",
 		outputWriter: of,
 		logger:       logger,
+		format:       opts.Format,
 		w:            cmp.Or(opts.Width, DefaultWidth),
 		h:            cmp.Or(opts.Height, DefaultHeight),
 	}, nil
@@ -154,7 +185,7 @@ func (s *RobotView) Write(bs []byte) (n int, err error) {
 // Close flushes the output buffer to the output file.
 func (s *RobotView) Close() error {
 	if s.outputBuffer.Len() > 0 {
-		fixture := robotFixture{Comment: s.outputBuffer.String()}
+		fixture := robotFixture{Kind: "log", Comment: s.outputBuffer.String(), Render: s.outputBuffer.String()}
 		if err := s.appendOutputFixture(fixture); err != nil {
 			return fmt.Errorf("append output fixture: %w", err)
 		}
@@ -171,8 +202,14 @@ func (s *RobotView) Prompt(fields ...ui.Field) error {
 	log := s.logger
 
 	var inputFixtures robotFixtureFile
-	if err := inputFixtures.ReadFile(s.fixtureFile); err != nil {
-		return fmt.Errorf("read fixture: %w", err)
+	var readErr error
+	if s.format == RobotFormatJSONL {
+		readErr = inputFixtures.ReadFileJSONL(s.fixtureFile)
+	} else {
+		readErr = inputFixtures.ReadFile(s.fixtureFile)
+	}
+	if readErr != nil {
+		return fmt.Errorf("read fixture: %w", readErr)
 	}
 
 fieldLoop:
@@ -221,15 +258,29 @@ fieldLoop:
 			}
 		}
 
+		var renderBuf strings.Builder
+		field.Render(&renderBuf)
+
+		// The JSONL format has no comment to fold earlier output into,
+		// so write it out as its own "log" record.
+		if s.format == RobotFormatJSONL && s.outputBuffer.Len() > 0 {
+			logFixture := robotFixture{Kind: "log", Render: s.outputBuffer.String()}
+			if err := s.appendOutputFixture(logFixture); err != nil {
+				return fmt.Errorf("append output fixture: %w", err)
+			}
+		}
+
 		var fieldView strings.Builder
 		fieldView.Write(s.outputBuffer.Bytes())
 		s.outputBuffer.Reset()
-		if title := field.Title(); title != "" {
-			fieldView.WriteString(field.Title())
+		title := field.Title()
+		if title != "" {
+			fieldView.WriteString(title)
 			fieldView.WriteString(": ")
 		}
-		field.Render(&fieldView)
-		if desc := field.Description(); desc != "" {
+		fieldView.WriteString(renderBuf.String())
+		desc := field.Description()
+		if desc != "" {
 			fieldView.WriteString("\n")
 			fieldView.WriteString(desc)
 		}
@@ -274,7 +325,11 @@ fieldLoop:
 			return fmt.Errorf("field [%d]: bad input: %w", fieldIdx, err)
 		}
 
+		fixture.Kind = "prompt"
 		fixture.Comment = fieldView.String()
+		fixture.Title = title
+		fixture.Description = desc
+		fixture.Render = renderBuf.String()
 		if err := s.appendOutputFixture(fixture); err != nil {
 			return fmt.Errorf("append output fixture: %w", err)
 		}
@@ -311,6 +366,17 @@ func (s *RobotView) nextPos() (int, error) {
 }
 
 func (s *RobotView) appendOutputFixture(fixture robotFixture) error {
+	if s.format == RobotFormatJSONL {
+		bs, err := fixture.MarshalJSONL(_timeNow())
+		if err != nil {
+			return fmt.Errorf("marshal output fixture: %w", err)
+		}
+		if _, err := s.outputWriter.Write(bs); err != nil {
+			return fmt.Errorf("write output fixture: %w", err)
+		}
+		return nil
+	}
+
 	p := printer{w: s.outputWriter}
 	fixture.Print(&p)
 	if err := p.Err(); err != nil {
@@ -388,9 +454,95 @@ func (sf robotFixtureFile) Write(w io.Writer) error {
 	return p.Err()
 }
 
+// ReadFileJSONL reads a [RobotFormatJSONL] fixture file.
+func (sf *robotFixtureFile) ReadFileJSONL(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	return sf.ReadJSONL(f)
+}
+
+// ReadJSONL reads fixtures in the [RobotFormatJSONL] format,
+// one JSON object per line.
+func (sf *robotFixtureFile) ReadJSONL(r io.Reader) error {
+	scan := bufio.NewScanner(r)
+	for scan.Scan() {
+		line := bytes.TrimSpace(scan.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var rec jsonlRecord
+		if err := json.Unmarshal(line, &rec); err != nil {
+			return fmt.Errorf("decode JSONL record: %w", err)
+		}
+
+		fixture := robotFixture{
+			Kind:        cmp.Or(rec.Kind, "prompt"),
+			Title:       rec.Title,
+			Description: rec.Description,
+			Render:      rec.Render,
+		}
+		if len(rec.Value) > 0 {
+			fixture.Value = string(rec.Value) + "\n"
+		}
+
+		*sf = append(*sf, fixture)
+	}
+
+	return scan.Err()
+}
+
 type robotFixture struct {
+	// Kind is "prompt" for fixtures that fed a value into a prompt,
+	// or "log" for non-prompt output captured between prompts.
+	//
+	// Only used by the JSONL format; the text format doesn't
+	// distinguish between the two.
+	Kind string
+
 	Comment string
 	Value   string // JSON
+
+	// Title, Description, and Render hold the prompt's rendered parts
+	// separately, for the JSONL format.
+	// For the text format, these are folded into Comment instead.
+	Title, Description, Render string
+}
+
+// jsonlRecord is the on-disk shape of a single [RobotFormatJSONL] line.
+type jsonlRecord struct {
+	Kind        string          `json:"kind"`
+	Title       string          `json:"title,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Render      string          `json:"render,omitempty"`
+	Value       json.RawMessage `json:"value,omitempty"`
+	Timestamp   string          `json:"timestamp,omitempty"`
+}
+
+// MarshalJSONL encodes the fixture as a single [RobotFormatJSONL] line,
+// including a trailing newline.
+func (sf *robotFixture) MarshalJSONL(ts time.Time) ([]byte, error) {
+	rec := jsonlRecord{
+		Kind:        cmp.Or(sf.Kind, "prompt"),
+		Title:       sf.Title,
+		Description: sf.Description,
+		Render:      sf.Render,
+		Timestamp:   ts.UTC().Format(time.RFC3339Nano),
+	}
+	if v := strings.TrimSpace(sf.Value); v != "" {
+		rec.Value = json.RawMessage(v)
+	}
+
+	bs, err := json.Marshal(rec)
+	if err != nil {
+		return nil, fmt.Errorf("marshal record: %w", err)
+	}
+
+	return append(bs, '\n'), nil
 }
 
 func (sf *robotFixture) Print(p *printer) {
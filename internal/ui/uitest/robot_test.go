@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/stretchr/testify/assert"
@@ -264,6 +265,104 @@ func TestRobotFile(t *testing.T) {
 	}
 }
 
+func TestRobotFileJSONL(t *testing.T) {
+	tests := []struct {
+		name string
+		give string
+		want robotFixtureFile
+	}{
+		{name: "Empty"},
+		{
+			name: "Single",
+			give: `{"kind": "prompt", "value": "bar"}` + "\n",
+			want: robotFixtureFile{
+				{Kind: "prompt", Value: `"bar"` + "\n"},
+			},
+		},
+		{
+			name: "Log",
+			give: `{"kind": "log", "render": "doing a thing"}` + "\n",
+			want: robotFixtureFile{
+				{Kind: "log", Render: "doing a thing"},
+			},
+		},
+		{
+			name: "DefaultsToPrompt",
+			give: `{"value": "bar"}` + "\n",
+			want: robotFixtureFile{
+				{Kind: "prompt", Value: `"bar"` + "\n"},
+			},
+		},
+		{
+			name: "BlankLinesIgnored",
+			give: "\n" + `{"kind": "prompt", "value": "bar"}` + "\n\n",
+			want: robotFixtureFile{
+				{Kind: "prompt", Value: `"bar"` + "\n"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got robotFixtureFile
+			require.NoError(t, got.ReadJSONL(strings.NewReader(tt.give)))
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func FuzzRobotFile_ReadJSONL(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte(`{"kind": "prompt", "value": "bar"}` + "\n"))
+	f.Add([]byte(`{"kind": "log", "render": "doing a thing"}` + "\n"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var sf robotFixtureFile
+		_ = sf.ReadJSONL(bytes.NewReader(data))
+		// Just make sure it doesn't panic or infinite loop.
+	})
+}
+
+func TestRobotView_jsonl(t *testing.T) {
+	restore := _timeNow
+	_timeNow = func() time.Time {
+		return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	}
+	defer func() { _timeNow = restore }()
+
+	dir := t.TempDir()
+	inputFile := filepath.Join(dir, "input")
+	outputFile := filepath.Join(dir, "output")
+
+	require.NoError(t, os.WriteFile(inputFile, []byte(text.Dedent(`
+		{"kind": "prompt", "value": "foo"}
+		{"kind": "prompt", "value": "bar"}
+	`)), 0o644))
+
+	view, err := NewRobotView(inputFile, &RobotViewOptions{
+		OutputFile: outputFile,
+		Format:     RobotFormatJSONL,
+	})
+	require.NoError(t, err)
+
+	field1 := &fakeField{View: "Who are you?"}
+	field2 := &fakeField{View: "Where are you going?"}
+
+	require.NoError(t, view.Prompt(field1, field2))
+	assert.Equal(t, "foo", field1.GotValue)
+	assert.Equal(t, "bar", field2.GotValue)
+
+	require.NoError(t, view.Close())
+
+	got, err := os.ReadFile(outputFile)
+	require.NoError(t, err)
+
+	assert.Equal(t, text.Dedent(`
+		{"kind":"prompt","render":"Who are you?","value":"foo","timestamp":"2024-01-02T03:04:05Z"}
+		{"kind":"prompt","render":"Where are you going?","value":"bar","timestamp":"2024-01-02T03:04:05Z"}
+	`), string(got))
+}
+
 func FuzzRobotFile_Read(f *testing.F) {
 	f.Add([]byte{})
 	f.Add([]byte(text.Dedent(`
@@ -16,7 +16,9 @@ import (
 	"github.com/mattn/go-isatty"
 	"go.abhg.dev/gs/internal/browser"
 	"go.abhg.dev/gs/internal/cli/shorthand"
+	"go.abhg.dev/gs/internal/correlation"
 	"go.abhg.dev/gs/internal/forge"
+	"go.abhg.dev/gs/internal/forge/bitbucket"
 	"go.abhg.dev/gs/internal/forge/github"
 	"go.abhg.dev/gs/internal/forge/gitlab"
 	"go.abhg.dev/gs/internal/git"
@@ -49,9 +51,15 @@ func main() {
 		Level: log.InfoLevel,
 	})
 
+	// Tag this invocation with a correlation ID so that its forge API
+	// requests and git subprocesses can be tied back together in logs.
+	correlationID := correlation.New()
+	logger = logger.With("correlation_id", correlationID)
+
 	// Register supported forges.
 	forge.Register(&github.Forge{Log: logger})
 	forge.Register(&gitlab.Forge{Log: logger})
+	forge.Register(&bitbucket.Forge{Log: logger})
 
 	styles := log.DefaultStyles()
 	styles.Levels[log.DebugLevel] = ui.NewStyle().SetString("DBG").Bold(true)
@@ -63,6 +71,7 @@ func main() {
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
+	ctx = correlation.NewContext(ctx, correlationID)
 
 	sigc := make(chan os.Signal, 1)
 	signal.Notify(sigc, os.Interrupt)
@@ -5,4 +5,5 @@ type stackCmd struct {
 	Restack stackRestackCmd `cmd:"" aliases:"r" help:"Restack a stack"`
 	Edit    stackEditCmd    `cmd:"" aliases:"e" help:"Edit the order of branches in a stack"`
 	Delete  stackDeleteCmd  `cmd:"" aliases:"d" released:"v0.16.0" help:"Delete all branches in a stack"`
+	Grep    stackGrepCmd    `cmd:"" help:"Search files changed by a stack"`
 }
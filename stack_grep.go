@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alecthomas/kong"
+	"go.abhg.dev/gs/internal/git"
+	"go.abhg.dev/gs/internal/spice"
+	"go.abhg.dev/gs/internal/spice/state"
+	"go.abhg.dev/gs/internal/text"
+)
+
+type stackGrepCmd struct {
+	Pattern string `arg:"" help:"Pattern to search for"`
+
+	Branch         string `help:"Branch to search the stack of" placeholder:"NAME" predictor:"trackedBranches"`
+	IgnoreCase     bool   `short:"i" help:"Case insensitive search"`
+	ExtendedRegexp bool   `short:"E" help:"Use POSIX extended regular expressions"`
+}
+
+func (*stackGrepCmd) Help() string {
+	return text.Dedent(`
+		The search is limited to files touched by commits between
+		trunk and the topmost branch of the current stack,
+		which is usually a much smaller set than the whole repository.
+
+		Use --branch to search the stack of a different branch.
+	`)
+}
+
+func (cmd *stackGrepCmd) AfterApply(ctx context.Context, wt *git.Worktree) error {
+	if cmd.Branch == "" {
+		branch, err := wt.CurrentBranch(ctx)
+		if err != nil {
+			return fmt.Errorf("get current branch: %w", err)
+		}
+		cmd.Branch = branch
+	}
+	return nil
+}
+
+func (cmd *stackGrepCmd) Run(
+	ctx context.Context,
+	kctx *kong.Context,
+	repo *git.Repository,
+	store *state.Store,
+	svc *spice.Service,
+) error {
+	stack, err := svc.ListStack(ctx, cmd.Branch)
+	if err != nil {
+		return fmt.Errorf("list stack: %w", err)
+	}
+	bottom, top := stack[0], stack[len(stack)-1]
+
+	base, err := repo.MergeBase(ctx, store.Trunk(), bottom)
+	if err != nil {
+		return fmt.Errorf("find merge base with trunk: %w", err)
+	}
+
+	paths, err := repo.DiffNameOnly(ctx, base.String(), top)
+	if err != nil {
+		return fmt.Errorf("list files changed by stack: %w", err)
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+
+	for match, err := range repo.Grep(ctx, git.GrepRequest{
+		Pattern:        cmd.Pattern,
+		Pathspecs:      paths,
+		IgnoreCase:     cmd.IgnoreCase,
+		ExtendedRegexp: cmd.ExtendedRegexp,
+	}) {
+		if err != nil {
+			return fmt.Errorf("grep: %w", err)
+		}
+
+		fmt.Fprintf(kctx.Stdout, "%s:%d:%d: %s\n", match.Path, match.Line, match.Column, match.Content)
+	}
+
+	return nil
+}